@@ -3,13 +3,30 @@ package main
 import (
 	"fmt"
 	_ "go-rental/docs"
+	"go-rental/internal/agreement"
+	"go-rental/internal/booking"
+	"go-rental/internal/branch"
 	"go-rental/internal/customer"
+	"go-rental/internal/damage"
+	"go-rental/internal/document"
+	"go-rental/internal/extra"
+	"go-rental/internal/inspection"
+	"go-rental/internal/invoice"
+	"go-rental/internal/maintenance"
+	"go-rental/internal/media"
+	"go-rental/internal/payment"
+	"go-rental/internal/pricing"
+	"go-rental/internal/promo"
 	"go-rental/internal/rent"
 	"go-rental/internal/user"
 	"go-rental/internal/vehicle"
+	"go-rental/internal/vehicleclass"
+	"go-rental/pkg/clock"
 	"go-rental/pkg/config"
 	"go-rental/pkg/middlewares"
+	"go-rental/pkg/storage"
 	"log"
+	"path/filepath"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -39,6 +56,9 @@ import (
 
 func main() {
 	cfg := config.LoadConfig()
+	if err := clock.Setup(cfg); err != nil {
+		log.Fatalf("Invalid time configuration: %v", err)
+	}
 	
 	r := gin.New()
 	r.Use(gin.Recovery())
@@ -68,13 +88,48 @@ func main() {
 	db := config.GetDB()
 	tables := []interface{}{
 		&user.User{},
+		&branch.Branch{},
+		&branch.OneWayFee{},
+		&vehicleclass.Class{},
 		&vehicle.Vehicle{},
 		&customer.Customer{},
 		&rent.Rent{},
+		&rent.RentCharge{},
+		&rent.RentStatusHistory{},
+		&rent.RentDriver{},
+		&rent.RentSegment{},
+		&booking.Booking{},
+		&pricing.PricingRule{},
+		&pricing.Holiday{},
+		&pricing.CancellationPolicy{},
+		&pricing.CancellationTier{},
+		&promo.Promo{},
+		&promo.Redemption{},
+		&extra.Extra{},
+		&extra.RentExtra{},
+		&payment.Payment{},
+		&invoice.Invoice{},
+		&invoice.InvoiceItem{},
+		&invoice.InvoiceSequence{},
+		&inspection.Inspection{},
+		&inspection.InspectionPhoto{},
+		&damage.DamageReport{},
+		&damage.DamagePhoto{},
+		&agreement.Agreement{},
+		&maintenance.Plan{},
+		&maintenance.WorkOrder{},
+		&media.VehicleMedia{},
+		&document.Document{},
 	}
 	if err := db.AutoMigrate(tables...); err != nil {
 		log.Fatalf("Database migration failed: %v", err)
 	}
+	// Index unik lama (rent, type) diganti (rent, vehicle, type) agar rent yang tukar kendaraan bisa diinspeksi per kendaraan
+	if db.Migrator().HasIndex(&inspection.Inspection{}, "idx_inspection_rent_type") {
+		if err := db.Migrator().DropIndex(&inspection.Inspection{}, "idx_inspection_rent_type"); err != nil {
+			log.Fatalf("Database migration failed: %v", err)
+		}
+	}
 	log.Println("✅ Migrasi database berhasil.")
 
 	// === Home Route ===
@@ -89,6 +144,11 @@ func main() {
 	// === Swagger Docs ===
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// === Uploaded Files ===
+	// Hanya media katalog kendaraan yang publik. Foto inspeksi dan kerusakan
+	// adalah bukti klaim, diunduh lewat endpoint inspection & damage yang butuh login.
+	r.Static("/uploads/vehicles", filepath.Join(cfg.UploadDir, "vehicles"))
+
 	user.SeedAdminUser()
 
 	userRepo := user.NewRepository(db)
@@ -96,18 +156,82 @@ func main() {
 	customeRepo := customer.NewRepository(db)
 	rentRepo := rent.NewRepository(db, userRepo, vehicleRepo, customeRepo)
 
-	rentService := rent.NewService(rentRepo, vehicleRepo, *cfg)
-	rentController := rent.NewController(rentService, vehicle.NewService(vehicleRepo, cfg), customer.NewService(customeRepo, cfg))
+	rentUow := rent.NewUnitOfWork(db, rentRepo, vehicleRepo, customeRepo)
+
+	branchService := branch.NewService(branch.NewRepository(db))
+	branchController := branch.NewController(branchService)
+	branch.SetupBranchRoutes(r, branchController, cfg)
+
+	vehicleClassService := vehicleclass.NewService(vehicleclass.NewRepository(db))
+
+	pricingService := pricing.NewService(pricing.NewRepository(db), vehicleRepo, cfg)
+	pricingController := pricing.NewController(pricingService)
+	pricing.SetupPricingRoutes(r, pricingController, cfg)
+
+	promoService := promo.NewService(promo.NewRepository(db))
+	promoController := promo.NewController(promoService)
+	promo.SetupPromoRoutes(r, promoController, cfg)
+
+	extraService := extra.NewService(extra.NewRepository(db))
+	extraController := extra.NewController(extraService)
+	extra.SetupExtraRoutes(r, extraController, cfg)
+
+	maintenanceService := maintenance.NewService(maintenance.NewRepository(db), vehicleRepo, vehicleClassService, cfg)
+
+	documentService := document.NewService(document.NewRepository(db), vehicleRepo, cfg)
+	documentController := document.NewController(documentService)
+	document.SetupDocumentRoutes(r, documentController, cfg)
+
+	paymentService := payment.NewService(payment.NewRepository(db), rentRepo, rentUow, cfg)
+	paymentController := payment.NewController(paymentService)
+	payment.SetupPaymentRoutes(r, paymentController, cfg)
+
+	invoiceService := invoice.NewService(invoice.NewRepository(db), rentRepo, paymentService, cfg)
+	invoiceController := invoice.NewController(invoiceService)
+	invoice.SetupInvoiceRoutes(r, invoiceController, cfg)
+
+	inspectionService := inspection.NewService(inspection.NewRepository(db), cfg)
+	inspectionController := inspection.NewController(inspectionService)
+	inspection.SetupInspectionRoutes(r, inspectionController, cfg)
+
+	damageService := damage.NewService(damage.NewRepository(db), rentUow, cfg)
+	damageController := damage.NewController(damageService)
+	damage.SetupDamageRoutes(r, damageController, cfg)
+
+	rentService := rent.NewService(rentRepo, vehicleRepo, rentUow, pricingService, promoService, extraService, maintenanceService, documentService, branchService, vehicleClassService, inspectionService, paymentService, invoiceService, *cfg)
+	rentController := rent.NewController(rentService, vehicle.NewService(vehicleRepo, rentService, cfg), customer.NewService(customeRepo, cfg))
 	rent.RentSetupRoutes(r, rentController, cfg)
 
+	vehicleClassController := vehicleclass.NewController(vehicleClassService, rentService)
+	vehicleclass.SetupVehicleClassRoutes(r, vehicleClassController, cfg)
+
+	maintenanceController := maintenance.NewController(maintenanceService, rentService)
+	maintenance.SetupMaintenanceRoutes(r, maintenanceController, cfg)
+
+	bookingService := booking.NewService(booking.NewRepository(db), rentUow, rentService)
+	bookingController := booking.NewController(bookingService)
+	booking.SetupBookingRoutes(r, bookingController, cfg)
+
+	agreementService := agreement.NewService(agreement.NewRepository(db), rentRepo, rentService, cfg)
+	agreementController := agreement.NewController(agreementService)
+	agreement.SetupAgreementRoutes(r, agreementController, cfg)
+
 	customerService := customer.NewService(customeRepo, cfg)
 	customerController := customer.NewController(customerService)
 	customer.SetupCustomerRoutes(r, customerController, cfg)
 
-	vehicleService := vehicle.NewService(vehicleRepo, cfg)
+	vehicleService := vehicle.NewService(vehicleRepo, rentService, cfg)
 	vehicleController := vehicle.NewController(vehicleService)
 	vehicle.SetupVehicleRoutes(r, vehicleController, cfg)
 
+	store, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("Invalid storage configuration: %v", err)
+	}
+	mediaService := media.NewService(media.NewRepository(db), vehicleRepo, store)
+	mediaController := media.NewController(mediaService)
+	media.SetupMediaRoutes(r, mediaController, cfg)
+
 
 	userService := user.NewService(userRepo, cfg)
 	userController := user.NewController(userService, cfg)
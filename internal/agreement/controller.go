@@ -0,0 +1,156 @@
+package agreement
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// GetAgreementDraft godoc
+// @Summary Get rental agreement draft
+// @Description Render the unsigned rental agreement (customer, vehicle, period, price, terms) to show on the signing tablet
+// @Tags Agreement
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/rent/{id}/agreement/draft [get]
+func (ctrl *Controller) GetAgreementDraft(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	body, err := ctrl.service.RenderDraft(uint(rentID))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	c.Header("Content-Disposition", "inline; filename=agreement-"+c.Param("id")+"-draft.pdf")
+	c.Data(http.StatusOK, "application/pdf", body)
+}
+
+// SignAgreement godoc
+// @Summary Sign rental agreement
+// @Description Upload the customer signature captured on the tablet; the signed PDF is stored with its SHA-256 hash
+// @Tags Agreement
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param signer_name formData string true "Name of the person signing"
+// @Param signature formData file true "Signature image (jpg/png, max 2MB)"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/agreement [post]
+func (ctrl *Controller) SignAgreement(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req SignRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	signature, err := c.FormFile("signature")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "signature image is required")
+		return
+	}
+
+	agreement, err := ctrl.service.SignAgreement(uint(rentID), &req, signature, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "agreement signed successfully", agreement)
+}
+
+// GetAgreement godoc
+// @Summary Get signed rental agreement
+// @Description Download the signed agreement PDF (default) or its metadata with format=json. The download is refused if the file no longer matches its stored hash
+// @Tags Agreement
+// @Produce application/pdf
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param format query string false "pdf or json"
+// @Success 200 {file} file
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/rent/{id}/agreement [get]
+func (ctrl *Controller) GetAgreement(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	if c.Query("format") == "json" {
+		agreement, err := ctrl.service.GetAgreement(uint(rentID))
+		if err != nil {
+			response.Error(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Success(c, http.StatusOK, "agreement retrieved successfully", agreement)
+		return
+	}
+
+	body, err := ctrl.service.DownloadAgreement(uint(rentID))
+	if err != nil {
+		response.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename=agreement-"+c.Param("id")+".pdf")
+	c.Data(http.StatusOK, "application/pdf", body)
+}
+
+// VerifyAgreement godoc
+// @Summary Verify rental agreement
+// @Description Recompute the SHA-256 of the stored agreement and compare it with the hash recorded at signing
+// @Tags Agreement
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/rent/{id}/agreement/verify [get]
+func (ctrl *Controller) VerifyAgreement(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	result, err := ctrl.service.VerifyAgreement(uint(rentID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "agreement verified", result)
+}
@@ -0,0 +1,135 @@
+package agreement
+
+import (
+	"fmt"
+	"go-rental/pkg/pdf"
+	"image"
+	"strings"
+)
+
+// defaultTerms dipakai jika AGREEMENT_TERMS_FILE tidak diisi
+var defaultTerms = []string{
+	"The vehicle may only be driven by the renter and the authorised drivers listed in this agreement.",
+	"The vehicle must be returned on the agreed date and time. Late returns are charged according to the late fee policy.",
+	"The renter is responsible for traffic fines, tolls and fuel during the rental period.",
+	"Damage or loss during the rental period is charged to the renter based on the damage report.",
+	"The vehicle may not be sublet, used for racing, or used to transport illegal goods.",
+	"Cancellation and no-show fees follow the cancellation policy of the vehicle type.",
+	"The prices listed are estimates; the final bill is calculated when the vehicle is returned.",
+}
+
+// renderPDF mencetak dokumen perjanjian. signature nil menghasilkan draft
+// dengan kolom tanda tangan kosong.
+func renderPDF(doc *Document, signature image.Image) []byte {
+	p := pdf.New()
+
+	y := 60.0
+	line := func(x float64, size float64, bold bool, text string) {
+		if y > pdf.PageHeight-60 {
+			p.AddPage()
+			y = 60
+		}
+		p.Text(x, y, size, bold, text)
+		y += size + 6
+	}
+
+	line(50, 16, true, doc.CompanyName)
+	line(50, 10, false, doc.CompanyAddress)
+	line(50, 10, false, doc.CompanyPhone)
+
+	y += 10
+	title := "RENTAL AGREEMENT #" + fmt.Sprint(doc.RentID)
+	if doc.SignedAt == "" {
+		title += " (DRAFT)"
+	}
+	line(50, 14, true, title)
+
+	line(50, 11, true, "Customer")
+	line(60, 10, false, "Name: "+doc.CustomerName)
+	line(60, 10, false, "ID card: "+doc.CustomerIDCard)
+	line(60, 10, false, "Phone: "+doc.CustomerPhone)
+	line(60, 10, false, "Address: "+doc.CustomerAddr)
+	if len(doc.Drivers) > 0 {
+		line(60, 10, false, "Additional drivers: "+strings.Join(doc.Drivers, ", "))
+	}
+
+	y += 4
+	line(50, 11, true, "Vehicle & period")
+	line(60, 10, false, "Vehicle: "+doc.VehicleName+" - "+doc.PlateNumber)
+	line(60, 10, false, "From: "+doc.PeriodStart)
+	line(60, 10, false, "Until: "+doc.PeriodEnd)
+
+	y += 4
+	line(50, 11, true, "Price")
+	for _, item := range doc.Items {
+		if y > pdf.PageHeight-60 {
+			p.AddPage()
+			y = 60
+		}
+		p.Text(60, y, 10, false, item.Description)
+		p.Text(470, y, 10, false, fmt.Sprintf("%.2f", item.Amount))
+		y += 16
+	}
+	p.Line(60, y-8, 545, y-8)
+	p.Text(60, y+4, 10, true, "Total")
+	p.Text(470, y+4, 10, true, fmt.Sprintf("%.2f", doc.Total))
+	y += 26
+
+	line(50, 11, true, "Terms and conditions")
+	for i, term := range doc.Terms {
+		for j, text := range wrap(term, 95) {
+			prefix := "    "
+			if j == 0 {
+				prefix = fmt.Sprintf("%d. ", i+1)
+			}
+			line(60, 9, false, prefix+text)
+		}
+	}
+
+	// Kolom tanda tangan, pindah halaman jika tidak cukup ruang
+	if y > pdf.PageHeight-160 {
+		p.AddPage()
+		y = 60
+	}
+	y += 10
+	line(50, 10, false, "Signed by the renter:")
+	if signature != nil {
+		// Skala tanda tangan ke kotak 200x80 dengan rasio tetap
+		bounds := signature.Bounds()
+		w, h := 200.0, 200.0*float64(bounds.Dy())/float64(bounds.Dx())
+		if h > 80 {
+			w, h = 80*float64(bounds.Dx())/float64(bounds.Dy()), 80
+		}
+		p.Image(50, y, w, h, signature)
+	}
+	y += 85
+	p.Line(50, y, 250, y)
+	y += 14
+	if doc.SignerName != "" {
+		line(50, 10, false, doc.SignerName)
+		line(50, 9, false, "Signed at: "+doc.SignedAt)
+	}
+	line(50, 8, false, "Generated at: "+doc.GeneratedAt)
+
+	return p.Bytes()
+}
+
+// wrap memecah teks per kata menjadi baris dengan panjang maksimal width karakter
+func wrap(text string, width int) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(text) {
+		if current != "" && len(current)+1+len(word) > width {
+			lines = append(lines, current)
+			current = ""
+		}
+		if current != "" {
+			current += " "
+		}
+		current += word
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
@@ -0,0 +1,66 @@
+package agreement
+
+import (
+	"go-rental/internal/user"
+	"time"
+)
+
+// Agreement adalah perjanjian sewa yang sudah ditandatangani customer.
+// PDF disimpan apa adanya di DocumentDir, SHA256 dicatat saat penandatanganan
+// sehingga perubahan file di kemudian hari bisa dibuktikan.
+type Agreement struct {
+	ID            uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID        uint      `json:"rent_id" gorm:"uniqueIndex"`
+	SignerName    string    `json:"signer_name"`
+	SignaturePath string    `json:"-"` // Path relatif terhadap DocumentDir
+	FilePath      string    `json:"-"` // Path relatif terhadap DocumentDir
+	SHA256        string    `json:"sha256" gorm:"type:char(64)"`
+	Size          int64     `json:"size"`
+	SignedAt      time.Time `json:"signed_at"`
+	WitnessedByID uint      `json:"witnessed_by_id"` // Staff yang mendampingi penandatanganan
+
+	// Relations
+	WitnessedBy user.User `json:"witnessed_by" gorm:"foreignKey:WitnessedByID"`
+}
+
+type SignRequest struct {
+	SignerName string `form:"signer_name" binding:"required"`
+}
+
+// VerifyResponse membandingkan hash tersimpan dengan hash file saat ini
+type VerifyResponse struct {
+	RentID     uint   `json:"rent_id"`
+	StoredHash string `json:"stored_hash"`
+	ActualHash string `json:"actual_hash"`
+	Valid      bool   `json:"valid"`
+	SignedAt   string `json:"signed_at"`
+}
+
+// Document adalah data yang dicetak ke PDF perjanjian
+type Document struct {
+	CompanyName    string
+	CompanyAddress string
+	CompanyPhone   string
+	RentID         uint
+	CustomerName   string
+	CustomerIDCard string
+	CustomerPhone  string
+	CustomerAddr   string
+	Drivers        []string
+	VehicleName    string
+	PlateNumber    string
+	PeriodStart    string
+	PeriodEnd      string
+	Items          []DocumentItem
+	Total          float64
+	Terms          []string
+	GeneratedAt    string
+	// Diisi hanya untuk dokumen yang ditandatangani
+	SignerName string
+	SignedAt   string
+}
+
+type DocumentItem struct {
+	Description string
+	Amount      float64
+}
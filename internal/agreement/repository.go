@@ -0,0 +1,30 @@
+package agreement
+
+import "gorm.io/gorm"
+
+type Repository interface {
+	Create(agreement *Agreement) error
+	FindByRentID(rentID uint) (*Agreement, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(agreement *Agreement) error {
+	return r.db.Omit("WitnessedBy").Create(agreement).Error
+}
+
+// FindByRentID implements Repository.
+func (r *repository) FindByRentID(rentID uint) (*Agreement, error) {
+	var agreement Agreement
+	if err := r.db.Preload("WitnessedBy").Where("rent_id = ?", rentID).First(&agreement).Error; err != nil {
+		return nil, err
+	}
+	return &agreement, nil
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
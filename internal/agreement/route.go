@@ -0,0 +1,18 @@
+package agreement
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupAgreementRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	agreement := r.Group("/api/rent")
+	{
+		agreement.GET("/:id/agreement/draft", middlewares.Authenticate(cfg), ctrl.GetAgreementDraft)
+		agreement.POST("/:id/agreement", middlewares.Authenticate(cfg), ctrl.SignAgreement)
+		agreement.GET("/:id/agreement", middlewares.Authenticate(cfg), ctrl.GetAgreement)
+		agreement.GET("/:id/agreement/verify", middlewares.Authenticate(cfg), ctrl.VerifyAgreement)
+	}
+}
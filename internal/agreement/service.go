@@ -0,0 +1,287 @@
+package agreement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-rental/internal/rent"
+	"go-rental/pkg/clock"
+	"go-rental/pkg/config"
+	"go-rental/pkg/upload"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Batas ukuran file tanda tangan dari tablet
+const maxSignatureSize = 2 << 20
+
+type Service interface {
+	RenderDraft(rentID uint) ([]byte, error)
+	SignAgreement(rentID uint, req *SignRequest, signature *multipart.FileHeader, witnessedBy uint) (*Agreement, error)
+	GetAgreement(rentID uint) (*Agreement, error)
+	DownloadAgreement(rentID uint) ([]byte, error)
+	VerifyAgreement(rentID uint) (*VerifyResponse, error)
+}
+
+type service struct {
+	repo        Repository
+	rentRepo    rent.Repository
+	rentService rent.Service
+	cfg         *config.Config
+}
+
+// RenderDraft implements Service.
+// Draft tanpa tanda tangan untuk ditampilkan ke customer di tablet.
+func (s *service) RenderDraft(rentID uint) ([]byte, error) {
+	rt, err := s.rentRepo.FindByID(rentID)
+	if err != nil {
+		return nil, errors.New("rent not found")
+	}
+	doc, err := s.buildDocument(rt)
+	if err != nil {
+		return nil, err
+	}
+	return renderPDF(doc, nil), nil
+}
+
+// SignAgreement implements Service.
+// Satu rent hanya punya satu perjanjian, dokumen yang sudah ditandatangani tidak bisa diganti.
+func (s *service) SignAgreement(rentID uint, req *SignRequest, signature *multipart.FileHeader, witnessedBy uint) (*Agreement, error) {
+	rt, err := s.rentRepo.FindByID(rentID)
+	if err != nil {
+		return nil, errors.New("rent not found")
+	}
+	if rt.Status != rent.StatusReserved && rt.Status != rent.StatusOngoing {
+		return nil, errors.New("agreement can only be signed for reserved or ongoing rent")
+	}
+	if _, err := s.repo.FindByRentID(rentID); err == nil {
+		return nil, errors.New("agreement already signed for this rent")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if signature == nil {
+		return nil, errors.New("signature image is required")
+	}
+	if !upload.IsImage(signature.Filename) {
+		return nil, errors.New("signature must be jpg or png")
+	}
+	if signature.Size > maxSignatureSize {
+		return nil, errors.New("signature image is too large (max 2MB)")
+	}
+	raw, err := readFile(signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature: %w", err)
+	}
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, errors.New("signature is not a valid image")
+	}
+
+	doc, err := s.buildDocument(rt)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	doc.SignerName = req.SignerName
+	doc.SignedAt = clock.Format(now)
+	body := renderPDF(doc, img)
+	sum := sha256.Sum256(body)
+
+	// Simpan ke DocumentDir/agreements/<rent_id>/, folder ini tidak disajikan publik
+	dir := filepath.Join("agreements", fmt.Sprint(rt.ID))
+	signaturePath := filepath.ToSlash(filepath.Join(dir, fmt.Sprintf("signature-%d%s", now.Unix(), strings.ToLower(filepath.Ext(signature.Filename)))))
+	filePath := filepath.ToSlash(filepath.Join(dir, fmt.Sprintf("agreement-%d.pdf", now.Unix())))
+	if err := s.writeDocument(signaturePath, raw); err != nil {
+		return nil, fmt.Errorf("failed to save signature: %w", err)
+	}
+	if err := s.writeDocument(filePath, body); err != nil {
+		return nil, fmt.Errorf("failed to save agreement: %w", err)
+	}
+
+	agreement := &Agreement{
+		RentID:        rt.ID,
+		SignerName:    req.SignerName,
+		SignaturePath: signaturePath,
+		FilePath:      filePath,
+		SHA256:        hex.EncodeToString(sum[:]),
+		Size:          int64(len(body)),
+		SignedAt:      now,
+		WitnessedByID: witnessedBy,
+	}
+	if err := s.repo.Create(agreement); err != nil {
+		// Kemungkinan ditandatangani bersamaan oleh request lain
+		os.Remove(filepath.Join(s.cfg.DocumentDir, signaturePath))
+		os.Remove(filepath.Join(s.cfg.DocumentDir, filePath))
+		return nil, fmt.Errorf("failed to save agreement: %w", err)
+	}
+
+	return s.repo.FindByRentID(rt.ID)
+}
+
+// GetAgreement implements Service.
+func (s *service) GetAgreement(rentID uint) (*Agreement, error) {
+	agreement, err := s.repo.FindByRentID(rentID)
+	if err != nil {
+		return nil, errors.New("agreement not found")
+	}
+	return agreement, nil
+}
+
+// DownloadAgreement implements Service.
+// File ditolak jika hash-nya tidak lagi sama dengan saat ditandatangani.
+func (s *service) DownloadAgreement(rentID uint) ([]byte, error) {
+	agreement, err := s.GetAgreement(rentID)
+	if err != nil {
+		return nil, err
+	}
+	body, err := os.ReadFile(filepath.Join(s.cfg.DocumentDir, agreement.FilePath))
+	if err != nil {
+		return nil, errors.New("agreement file not found")
+	}
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != agreement.SHA256 {
+		return nil, errors.New("agreement file has been altered")
+	}
+	return body, nil
+}
+
+// VerifyAgreement implements Service.
+func (s *service) VerifyAgreement(rentID uint) (*VerifyResponse, error) {
+	agreement, err := s.GetAgreement(rentID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &VerifyResponse{
+		RentID:     rentID,
+		StoredHash: agreement.SHA256,
+		SignedAt:   clock.Format(agreement.SignedAt),
+	}
+	body, err := os.ReadFile(filepath.Join(s.cfg.DocumentDir, agreement.FilePath))
+	if err != nil {
+		return resp, nil // File hilang dianggap tidak valid
+	}
+	sum := sha256.Sum256(body)
+	resp.ActualHash = hex.EncodeToString(sum[:])
+	resp.Valid = resp.ActualHash == resp.StoredHash
+	return resp, nil
+}
+
+// buildDocument menyalin data rent ke dokumen perjanjian. Rent yang belum
+// complete dihitung dari proyeksi harga ditambah charge yang sudah dicatat.
+func (s *service) buildDocument(rt *rent.Rent) (*Document, error) {
+	// Reservasi class baru punya kendaraan (dan plat nomor) setelah pickup
+	if rt.VehicleID == nil {
+		return nil, errors.New("rent has no vehicle assigned yet, generate the agreement after pickup")
+	}
+	terms, err := s.terms()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		CompanyName:    s.cfg.CompanyName,
+		CompanyAddress: s.cfg.CompanyAddress,
+		CompanyPhone:   s.cfg.CompanyPhone,
+		RentID:         rt.ID,
+		CustomerName:   rt.Customer.Name,
+		CustomerIDCard: rt.Customer.IDCard,
+		CustomerPhone:  rt.Customer.Phone,
+		CustomerAddr:   rt.Customer.Address,
+		VehicleName:    fmt.Sprintf("%s %s (%d)", rt.Vehicle.Brand, rt.Vehicle.Model, rt.Vehicle.Year),
+		PlateNumber:    rt.Vehicle.PlateNumber,
+		PeriodStart:    clock.Format(rt.PlannedStartDate),
+		PeriodEnd:      clock.FormatPtr(rt.PlannedEndDate),
+		Terms:          terms,
+		GeneratedAt:    clock.Format(time.Now()),
+	}
+	for _, d := range rt.Drivers {
+		doc.Drivers = append(doc.Drivers, d.Customer.Name+" ("+d.Customer.IDCard+")")
+	}
+
+	switch rt.Status {
+	case rent.StatusCompleted, rent.StatusClosed:
+		// Tagihan final sudah lengkap di rincian charge
+		for _, charge := range rt.Charges {
+			doc.Items = append(doc.Items, DocumentItem{Description: charge.Description, Amount: charge.Amount})
+		}
+		doc.Total = rt.TotalPrice
+		return doc, nil
+	case rent.StatusReserved, rent.StatusOngoing:
+	default:
+		return nil, fmt.Errorf("agreement is not available for %s rent", rt.Status)
+	}
+
+	// Sebelum complete, charge yang ada hanya charge tambahan (manual, kerusakan),
+	// harga sewa diambil dari proyeksi sampai expected return
+	quote, err := s.rentService.EstimateRent(rt.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range quote.Items {
+		doc.Items = append(doc.Items, DocumentItem{Description: item.Description, Amount: item.Amount})
+	}
+	doc.Total = quote.Total
+	for _, charge := range rt.Charges {
+		doc.Items = append(doc.Items, DocumentItem{Description: charge.Description, Amount: charge.Amount})
+		doc.Total += charge.Amount
+	}
+	doc.Total = math.Round(doc.Total*100) / 100
+	return doc, nil
+}
+
+// terms membaca syarat & ketentuan dari AgreementTermsFile, default jika kosong
+func (s *service) terms() ([]string, error) {
+	if s.cfg.AgreementTermsFile == "" {
+		return defaultTerms, nil
+	}
+	content, err := os.ReadFile(s.cfg.AgreementTermsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agreement terms: %w", err)
+	}
+	var terms []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			terms = append(terms, line)
+		}
+	}
+	return terms, nil
+}
+
+func (s *service) writeDocument(relPath string, content []byte) error {
+	dst := filepath.Join(s.cfg.DocumentDir, relPath)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, content, 0o640)
+}
+
+func readFile(fh *multipart.FileHeader) ([]byte, error) {
+	src, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	return io.ReadAll(src)
+}
+
+func NewService(repo Repository, rentRepo rent.Repository, rentService rent.Service, cfg *config.Config) Service {
+	return &service{
+		repo:        repo,
+		rentRepo:    rentRepo,
+		rentService: rentService,
+		cfg:         cfg,
+	}
+}
@@ -0,0 +1,143 @@
+package booking
+
+import (
+	"go-rental/internal/rent"
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateBooking godoc
+// @Summary Create group booking
+// @Description Book several vehicles for one customer under one agreement; each item becomes a rent
+// @Tags Booking
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body BookingRequest true "Booking data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/booking/ [post]
+func (ctrl *Controller) CreateBooking(c *gin.Context) {
+	var req BookingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	booking, err := ctrl.service.CreateBooking(&req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "booking created successfully", booking)
+}
+
+// GetBookings godoc
+// @Summary Get group bookings
+// @Description Retrieve group bookings, optionally filtered by customer
+// @Tags Booking
+// @Produce json
+// @Security BearerAuth
+// @Param customer_id query int false "Customer ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/booking/ [get]
+func (ctrl *Controller) GetBookings(c *gin.Context) {
+	var filter BookingFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	bookings, err := ctrl.service.GetAllBookings(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "bookings retrieved successfully", bookings)
+}
+
+// GetBookingByID godoc
+// @Summary Get group booking by ID
+// @Description Retrieve a group booking with its vehicle items and total
+// @Tags Booking
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Booking ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/booking/{id} [get]
+func (ctrl *Controller) GetBookingByID(c *gin.Context) {
+	bookingID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid booking ID")
+		return
+	}
+	booking, err := ctrl.service.GetBookingByID(uint(bookingID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "booking retrieved successfully", booking)
+}
+
+// CancelBooking godoc
+// @Summary Cancel group booking
+// @Description Cancel every reserved rent of the booking. Picked-up rents keep running and must be completed
+// @Tags Booking
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Booking ID"
+// @Param data body rent.TransitionRequest true "Cancellation reason"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/booking/{id}/cancel [post]
+func (ctrl *Controller) CancelBooking(c *gin.Context) {
+	bookingID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid booking ID")
+		return
+	}
+
+	var req rent.TransitionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	booking, err := ctrl.service.CancelBooking(uint(bookingID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "booking cancelled successfully", booking)
+}
@@ -0,0 +1,59 @@
+package booking
+
+import (
+	"fmt"
+	"go-rental/internal/rent"
+	"go-rental/pkg/clock"
+	"math"
+)
+
+// toBookingResponse menyusun response booking. Rent yang belum complete
+// belum punya tagihan sewa, jadi totalnya dari proyeksi harga ditambah
+// charge yang sudah dicatat (manual, kerusakan).
+func (s *service) toBookingResponse(b *Booking) (*BookingResponse, error) {
+	resp := &BookingResponse{
+		ID:        b.ID,
+		Customer:  b.Customer,
+		Status:    bookingStatus(b.Rents),
+		Items:     []*rent.RentResponse{},
+		Notes:     b.Notes,
+		CreatedBy: b.CreatedBy,
+		CreatedAt: clock.Format(b.CreatedAt),
+	}
+	for i := range b.Rents {
+		rt := &b.Rents[i]
+		resp.Items = append(resp.Items, s.rentService.ToResponse(rt))
+
+		active := rt.Status == rent.StatusReserved || rt.Status == rent.StatusOngoing
+		if !active || rt.PlannedEndDate == nil {
+			resp.TotalPrice += rt.TotalPrice
+			continue
+		}
+		quote, err := s.rentService.EstimateRent(rt.ID)
+		if err != nil {
+			return nil, fmt.Errorf("rent %d: %w", rt.ID, err)
+		}
+		resp.TotalPrice += quote.Total + rt.TotalPrice
+		resp.Estimated = true
+	}
+	resp.TotalPrice = math.Round(resp.TotalPrice*100) / 100
+	return resp, nil
+}
+
+// bookingStatus: cancelled jika semua rent batal, completed jika tidak ada
+// lagi rent yang reserved/ongoing, selain itu active
+func bookingStatus(rents []rent.Rent) BookingStatus {
+	cancelled := 0
+	for _, rt := range rents {
+		switch rt.Status {
+		case rent.StatusReserved, rent.StatusOngoing:
+			return StatusActive
+		case rent.StatusCancelled, rent.StatusNoShow:
+			cancelled++
+		}
+	}
+	if len(rents) > 0 && cancelled == len(rents) {
+		return StatusCancelled
+	}
+	return StatusCompleted
+}
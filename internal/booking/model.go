@@ -0,0 +1,71 @@
+package booking
+
+import (
+	"go-rental/internal/customer"
+	"go-rental/internal/extra"
+	"go-rental/internal/rent"
+	"go-rental/internal/user"
+	"time"
+)
+
+type BookingStatus string
+
+// Status booking diturunkan dari status rent di dalamnya
+const (
+	StatusActive    BookingStatus = "active"
+	StatusCompleted BookingStatus = "completed"
+	StatusCancelled BookingStatus = "cancelled"
+)
+
+// Booking mengelompokkan beberapa rent (satu per kendaraan) milik satu customer
+// dalam satu perjanjian sewa. Tiap kendaraan tetap dikembalikan lewat rent-nya.
+type Booking struct {
+	ID          uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	CustomerID  uint      `json:"customer_id"`
+	Notes       string    `json:"notes"`
+	CreatedByID uint      `json:"created_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// Relations
+	Customer  customer.Customer `json:"customer"   gorm:"foreignKey:CustomerID"`
+	CreatedBy user.User         `json:"created_by" gorm:"foreignKey:CreatedByID"`
+	Rents     []rent.Rent       `json:"rents"      gorm:"foreignKey:BookingID"`
+}
+
+type BookingItemRequest struct {
+	// Isi salah satu: kendaraan tertentu atau reservasi per class
+	VehicleID *uint               `json:"vehicle_id" binding:"required_without=ClassID"`
+	ClassID   *uint               `json:"class_id"   binding:"required_without=VehicleID"`
+	StartDate *time.Time          `json:"start_date"` // kosong = ikut periode booking
+	EndDate   *time.Time          `json:"end_date"`
+	Notes     string              `json:"notes"`
+	Extras    []extra.ItemRequest `json:"extras" binding:"omitempty,dive"`
+}
+
+type BookingRequest struct {
+	CustomerID uint                 `json:"customer_id" binding:"required"`
+	StartDate  *time.Time           `json:"start_date"` // kosong atau sudah lewat = walk-in
+	EndDate    *time.Time           `json:"end_date"`
+	Notes      string               `json:"notes"`
+	Items      []BookingItemRequest `json:"items" binding:"required,min=1,dive"`
+	// Branch opsional untuk semua item, default lokasi masing-masing kendaraan
+	PickupBranchID  *uint `json:"pickup_branch_id"`
+	DropoffBranchID *uint `json:"dropoff_branch_id"`
+}
+
+type BookingFilter struct {
+	CustomerID *uint `form:"customer_id"`
+}
+
+type BookingResponse struct {
+	ID         uint                 `json:"id"`
+	Customer   customer.Customer    `json:"customer"`
+	Status     BookingStatus        `json:"status"`
+	TotalPrice float64              `json:"total_price"`
+	Estimated  bool                 `json:"estimated"` // true jika total memakai proyeksi harga rent yang belum complete
+	Items      []*rent.RentResponse `json:"items"`
+	Notes      string               `json:"notes"`
+	CreatedBy  user.User            `json:"created_by"`
+	CreatedAt  string               `json:"created_at"`
+}
@@ -0,0 +1,60 @@
+package booking
+
+import (
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	Create(booking *Booking) error
+	FindByID(id uint) (*Booking, error)
+	FindAll(filter *BookingFilter) ([]*Booking, error)
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(booking *Booking) error {
+	return r.db.Omit("Rents").Create(booking).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Booking, error) {
+	var booking Booking
+	if err := preloadBooking(r.db).First(&booking, id).Error; err != nil {
+		return nil, err
+	}
+	return &booking, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *BookingFilter) ([]*Booking, error) {
+	var bookings []*Booking
+	query := preloadBooking(r.db)
+	if filter.CustomerID != nil {
+		query = query.Where("customer_id = ?", *filter.CustomerID)
+	}
+	if err := query.Order("id desc").Find(&bookings).Error; err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func preloadBooking(db *gorm.DB) *gorm.DB {
+	return db.Preload("Customer").Preload("CreatedBy").
+		Preload("Rents.Vehicle").Preload("Rents.Customer").Preload("Rents.Promo").Preload("Rents.Charges").
+		Preload("Rents.CreatedBy").Preload("Rents.UpdatedBy")
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,18 @@
+package booking
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupBookingRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	booking := r.Group("/api/booking")
+	{
+		booking.POST("/", middlewares.Authenticate(cfg), ctrl.CreateBooking)
+		booking.GET("/", middlewares.Authenticate(cfg), ctrl.GetBookings)
+		booking.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetBookingByID)
+		booking.POST("/:id/cancel", middlewares.Authenticate(cfg), ctrl.CancelBooking)
+	}
+}
@@ -0,0 +1,140 @@
+package booking
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/rent"
+)
+
+type Service interface {
+	CreateBooking(req *BookingRequest, createdBy uint) (*BookingResponse, error)
+	GetBookingByID(id uint) (*BookingResponse, error)
+	GetAllBookings(filter *BookingFilter) ([]*BookingResponse, error)
+	CancelBooking(id uint, req *rent.TransitionRequest, updatedBy uint) (*BookingResponse, error)
+}
+
+type service struct {
+	repo        Repository
+	rentUow     rent.UnitOfWork
+	rentService rent.Service
+}
+
+// CreateBooking implements Service.
+// Booking dan semua rent-nya dibuat dalam satu transaksi: jika satu
+// kendaraan tidak tersedia, seluruh booking dibatalkan.
+func (s *service) CreateBooking(req *BookingRequest, createdBy uint) (*BookingResponse, error) {
+	booking := &Booking{
+		CustomerID:  req.CustomerID,
+		Notes:       req.Notes,
+		CreatedByID: createdBy,
+	}
+
+	err := s.rentUow.Do(func(repos *rent.Repositories) error {
+		if err := s.repo.WithTx(repos.Tx).Create(booking); err != nil {
+			return fmt.Errorf("failed to create booking: %w", err)
+		}
+
+		for i, item := range req.Items {
+			start, end := req.StartDate, req.EndDate
+			if item.StartDate != nil {
+				start = item.StartDate
+			}
+			if item.EndDate != nil {
+				end = item.EndDate
+			}
+			if end == nil {
+				return fmt.Errorf("item %d: end_date is required", i+1)
+			}
+
+			rentReq := &rent.RentRequest{
+				CustomerID: req.CustomerID,
+				VehicleID:  item.VehicleID,
+				ClassID:    item.ClassID,
+				Notes:      item.Notes,
+				Extras:     item.Extras,
+				StartDate:  start,
+				EndDate:    end,
+				BookingID:  &booking.ID,
+
+				PickupBranchID:  req.PickupBranchID,
+				DropoffBranchID: req.DropoffBranchID,
+			}
+			if _, err := s.rentService.CreateRentTx(repos, rentReq, createdBy); err != nil {
+				return fmt.Errorf("item %d: %w", i+1, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBookingByID(booking.ID)
+}
+
+// GetBookingByID implements Service.
+func (s *service) GetBookingByID(id uint) (*BookingResponse, error) {
+	booking, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	return s.toBookingResponse(booking)
+}
+
+// GetAllBookings implements Service.
+func (s *service) GetAllBookings(filter *BookingFilter) ([]*BookingResponse, error) {
+	bookings, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve bookings: %w", err)
+	}
+	responses := []*BookingResponse{}
+	for _, booking := range bookings {
+		resp, err := s.toBookingResponse(booking)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// CancelBooking implements Service.
+// Membatalkan semua rent yang masih reserved dalam satu transaksi. Rent yang
+// sudah di-pickup tetap berjalan dan harus di-complete, rent yang sudah
+// dikembalikan tidak disentuh.
+func (s *service) CancelBooking(id uint, req *rent.TransitionRequest, updatedBy uint) (*BookingResponse, error) {
+	booking, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+
+	err = s.rentUow.Do(func(repos *rent.Repositories) error {
+		cancelled := 0
+		for _, rt := range booking.Rents {
+			if rt.Status != rent.StatusReserved {
+				continue
+			}
+			if err := s.rentService.CancelRentTx(repos, rt.ID, req, updatedBy); err != nil {
+				return fmt.Errorf("rent %d: %w", rt.ID, err)
+			}
+			cancelled++
+		}
+		if cancelled == 0 {
+			return errors.New("booking has no reserved rent to cancel")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBookingByID(id)
+}
+
+func NewService(repo Repository, rentUow rent.UnitOfWork, rentService rent.Service) Service {
+	return &service{
+		repo:        repo,
+		rentUow:     rentUow,
+		rentService: rentService,
+	}
+}
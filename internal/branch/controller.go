@@ -0,0 +1,202 @@
+package branch
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateBranch godoc
+// @Summary Create branch
+// @Description Register a new branch office where vehicles are picked up and returned
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body BranchRequest true "Branch data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/branch/ [post]
+func (ctrl *Controller) CreateBranch(c *gin.Context) {
+	var req BranchRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	branch, err := ctrl.service.CreateBranch(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "branch created successfully", branch)
+}
+
+// GetBranches godoc
+// @Summary Get branches
+// @Description Retrieve branches, optionally filtered by city or status
+// @Tags Branch
+// @Produce json
+// @Param city query string false "City"
+// @Param active query bool false "Active only"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/branch/ [get]
+func (ctrl *Controller) GetBranches(c *gin.Context) {
+	var filter BranchFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	branches, err := ctrl.service.GetAllBranches(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "branches retrieved successfully", branches)
+}
+
+// GetBranchByID godoc
+// @Summary Get branch by ID
+// @Description Retrieve a branch by its ID
+// @Tags Branch
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/branch/{id} [get]
+func (ctrl *Controller) GetBranchByID(c *gin.Context) {
+	branchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid branch ID")
+		return
+	}
+
+	branch, err := ctrl.service.GetBranchByID(uint(branchID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "branch retrieved successfully", branch)
+}
+
+// UpdateBranch godoc
+// @Summary Update branch
+// @Description Update branch details or deactivate it
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Branch ID"
+// @Param data body UpdateBranchRequest true "Branch update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/branch/{id} [put]
+func (ctrl *Controller) UpdateBranch(c *gin.Context) {
+	branchID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid branch ID")
+		return
+	}
+
+	var req UpdateBranchRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	branch, err := ctrl.service.UpdateBranch(uint(branchID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "branch updated successfully", branch)
+}
+
+// SetOneWayFee godoc
+// @Summary Set one-way fee
+// @Description Create or replace the fee charged when a vehicle picked up at one branch is returned at another
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body OneWayFeeRequest true "Route fee"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/branch/one-way-fees [put]
+func (ctrl *Controller) SetOneWayFee(c *gin.Context) {
+	var req OneWayFeeRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	fee, err := ctrl.service.SetOneWayFee(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "one-way fee saved successfully", fee)
+}
+
+// GetOneWayFees godoc
+// @Summary Get one-way fees
+// @Description Retrieve one-way fees, optionally filtered by origin or destination branch
+// @Tags Branch
+// @Produce json
+// @Param from_branch_id query int false "Pickup branch ID"
+// @Param to_branch_id query int false "Drop-off branch ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/branch/one-way-fees [get]
+func (ctrl *Controller) GetOneWayFees(c *gin.Context) {
+	var filter OneWayFeeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	fees, err := ctrl.service.GetOneWayFees(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "one-way fees retrieved successfully", fees)
+}
+
+// DeleteOneWayFee godoc
+// @Summary Delete one-way fee
+// @Description Remove a route fee; the route becomes free of charge
+// @Tags Branch
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "One-way fee ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/branch/one-way-fees/{id} [delete]
+func (ctrl *Controller) DeleteOneWayFee(c *gin.Context) {
+	feeID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid one-way fee ID")
+		return
+	}
+
+	if err := ctrl.service.DeleteOneWayFee(uint(feeID)); err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "one-way fee deleted successfully", nil)
+}
@@ -0,0 +1,66 @@
+package branch
+
+import "time"
+
+// Branch adalah kantor / lokasi tempat kendaraan di-pickup dan dikembalikan
+type Branch struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Code      string    `json:"code" gorm:"type:varchar(20);uniqueIndex"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	City      string    `json:"city" gorm:"index"`
+	Phone     string    `json:"phone"`
+	Active    bool      `json:"active"` // branch nonaktif tidak bisa dipilih untuk rent baru
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OneWayFee adalah biaya tambahan jika kendaraan diambil di FromBranch dan
+// dikembalikan di ToBranch. Rute tanpa tarif tidak dikenakan biaya.
+type OneWayFee struct {
+	ID           uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	FromBranchID uint      `json:"from_branch_id" gorm:"uniqueIndex:idx_one_way_route"`
+	ToBranchID   uint      `json:"to_branch_id" gorm:"uniqueIndex:idx_one_way_route"`
+	Fee          float64   `json:"fee"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	FromBranch Branch `json:"from_branch" gorm:"foreignKey:FromBranchID"`
+	ToBranch   Branch `json:"to_branch" gorm:"foreignKey:ToBranchID"`
+}
+
+func (OneWayFee) TableName() string {
+	return "branch_one_way_fees"
+}
+
+type BranchRequest struct {
+	Code    string `json:"code" form:"code" binding:"required,max=20"`
+	Name    string `json:"name" form:"name" binding:"required"`
+	Address string `json:"address" form:"address"`
+	City    string `json:"city" form:"city"`
+	Phone   string `json:"phone" form:"phone"`
+}
+
+type UpdateBranchRequest struct {
+	Name    *string `json:"name" form:"name" binding:"omitempty"`
+	Address *string `json:"address" form:"address" binding:"omitempty"`
+	City    *string `json:"city" form:"city" binding:"omitempty"`
+	Phone   *string `json:"phone" form:"phone" binding:"omitempty"`
+	Active  *bool   `json:"active" form:"active" binding:"omitempty"`
+}
+
+type BranchFilter struct {
+	City   *string `form:"city"`
+	Active *bool   `form:"active"`
+}
+
+type OneWayFeeRequest struct {
+	FromBranchID uint    `json:"from_branch_id" form:"from_branch_id" binding:"required"`
+	ToBranchID   uint    `json:"to_branch_id" form:"to_branch_id" binding:"required"`
+	Fee          float64 `json:"fee" form:"fee" binding:"min=0"`
+}
+
+type OneWayFeeFilter struct {
+	FromBranchID *uint `form:"from_branch_id"`
+	ToBranchID   *uint `form:"to_branch_id"`
+}
@@ -0,0 +1,120 @@
+package branch
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Create(branch *Branch) error
+	FindByID(id uint) (*Branch, error)
+	FindAll(filter *BranchFilter) ([]*Branch, error)
+	Update(branch *Branch) error
+
+	UpsertOneWayFee(fee *OneWayFee) error
+	FindOneWayFeeByID(id uint) (*OneWayFee, error)
+	FindOneWayFee(fromID, toID uint) (*OneWayFee, error)
+	FindOneWayFees(filter *OneWayFeeFilter) ([]*OneWayFee, error)
+	DeleteOneWayFee(fee *OneWayFee) error
+
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(branch *Branch) error {
+	return r.db.Create(branch).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Branch, error) {
+	var branch Branch
+	if err := r.db.First(&branch, id).Error; err != nil {
+		return nil, err
+	}
+	return &branch, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *BranchFilter) ([]*Branch, error) {
+	var branches []*Branch
+	query := r.db.Model(&Branch{})
+	if filter.City != nil {
+		query = query.Where("city LIKE ?", "%"+*filter.City+"%")
+	}
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+	if err := query.Order("code asc").Find(&branches).Error; err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(branch *Branch) error {
+	return r.db.Save(branch).Error
+}
+
+// UpsertOneWayFee implements Repository.
+// Satu rute hanya punya satu tarif, tarif lama ditimpa.
+func (r *repository) UpsertOneWayFee(fee *OneWayFee) error {
+	return r.db.Omit("FromBranch", "ToBranch").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "from_branch_id"}, {Name: "to_branch_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"fee", "updated_at"}),
+	}).Create(fee).Error
+}
+
+// FindOneWayFeeByID implements Repository.
+func (r *repository) FindOneWayFeeByID(id uint) (*OneWayFee, error) {
+	var fee OneWayFee
+	if err := r.db.Preload("FromBranch").Preload("ToBranch").First(&fee, id).Error; err != nil {
+		return nil, err
+	}
+	return &fee, nil
+}
+
+// FindOneWayFee implements Repository.
+func (r *repository) FindOneWayFee(fromID, toID uint) (*OneWayFee, error) {
+	var fee OneWayFee
+	err := r.db.Preload("FromBranch").Preload("ToBranch").
+		Where("from_branch_id = ? AND to_branch_id = ?", fromID, toID).
+		First(&fee).Error
+	if err != nil {
+		return nil, err
+	}
+	return &fee, nil
+}
+
+// FindOneWayFees implements Repository.
+func (r *repository) FindOneWayFees(filter *OneWayFeeFilter) ([]*OneWayFee, error) {
+	var fees []*OneWayFee
+	query := r.db.Preload("FromBranch").Preload("ToBranch").Model(&OneWayFee{})
+	if filter.FromBranchID != nil {
+		query = query.Where("from_branch_id = ?", *filter.FromBranchID)
+	}
+	if filter.ToBranchID != nil {
+		query = query.Where("to_branch_id = ?", *filter.ToBranchID)
+	}
+	if err := query.Order("from_branch_id asc, to_branch_id asc").Find(&fees).Error; err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
+
+// DeleteOneWayFee implements Repository.
+func (r *repository) DeleteOneWayFee(fee *OneWayFee) error {
+	return r.db.Delete(fee).Error
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,21 @@
+package branch
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupBranchRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	branch := r.Group("/api/branch")
+	{
+		branch.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateBranch)
+		branch.GET("/", ctrl.GetBranches)
+		branch.GET("/one-way-fees", ctrl.GetOneWayFees)
+		branch.PUT("/one-way-fees", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.SetOneWayFee)
+		branch.DELETE("/one-way-fees/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteOneWayFee)
+		branch.GET("/:id", ctrl.GetBranchByID)
+		branch.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateBranch)
+	}
+}
@@ -0,0 +1,169 @@
+package branch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreateBranch(req *BranchRequest) (*Branch, error)
+	GetAllBranches(filter *BranchFilter) ([]*Branch, error)
+	GetBranchByID(id uint) (*Branch, error)
+	UpdateBranch(id uint, req *UpdateBranchRequest) (*Branch, error)
+
+	SetOneWayFee(req *OneWayFeeRequest) (*OneWayFee, error)
+	GetOneWayFees(filter *OneWayFeeFilter) ([]*OneWayFee, error)
+	DeleteOneWayFee(id uint) error
+
+	// Dipanggil dari transaksi rent
+	CheckActive(tx *gorm.DB, id uint) (*Branch, error)
+	OneWayFeeFor(tx *gorm.DB, fromID, toID uint) (float64, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+// CreateBranch implements Service.
+func (s *service) CreateBranch(req *BranchRequest) (*Branch, error) {
+	branch := &Branch{
+		Code:    strings.ToUpper(strings.TrimSpace(req.Code)),
+		Name:    req.Name,
+		Address: req.Address,
+		City:    req.City,
+		Phone:   req.Phone,
+		Active:  true,
+	}
+	if err := s.repo.Create(branch); err != nil {
+		return nil, fmt.Errorf("failed to create branch: %w", err)
+	}
+	return branch, nil
+}
+
+// GetAllBranches implements Service.
+func (s *service) GetAllBranches(filter *BranchFilter) ([]*Branch, error) {
+	branches, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve branches: %w", err)
+	}
+	return branches, nil
+}
+
+// GetBranchByID implements Service.
+func (s *service) GetBranchByID(id uint) (*Branch, error) {
+	branch, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("branch not found")
+	}
+	return branch, nil
+}
+
+// UpdateBranch implements Service.
+// Branch tidak dihapus, cukup dinonaktifkan agar riwayat rent tetap utuh.
+func (s *service) UpdateBranch(id uint, req *UpdateBranchRequest) (*Branch, error) {
+	branch, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("branch not found")
+	}
+
+	// Update only fields that are not nil
+	if req.Name != nil {
+		branch.Name = *req.Name
+	}
+	if req.Address != nil {
+		branch.Address = *req.Address
+	}
+	if req.City != nil {
+		branch.City = *req.City
+	}
+	if req.Phone != nil {
+		branch.Phone = *req.Phone
+	}
+	if req.Active != nil {
+		branch.Active = *req.Active
+	}
+
+	if err := s.repo.Update(branch); err != nil {
+		return nil, fmt.Errorf("failed to update branch: %w", err)
+	}
+	return branch, nil
+}
+
+// SetOneWayFee implements Service.
+// Tarif berlaku satu arah; rute sebaliknya perlu diatur sendiri.
+func (s *service) SetOneWayFee(req *OneWayFeeRequest) (*OneWayFee, error) {
+	if req.FromBranchID == req.ToBranchID {
+		return nil, errors.New("from_branch_id and to_branch_id must be different")
+	}
+	if _, err := s.repo.FindByID(req.FromBranchID); err != nil {
+		return nil, errors.New("from branch not found")
+	}
+	if _, err := s.repo.FindByID(req.ToBranchID); err != nil {
+		return nil, errors.New("to branch not found")
+	}
+
+	fee := &OneWayFee{
+		FromBranchID: req.FromBranchID,
+		ToBranchID:   req.ToBranchID,
+		Fee:          req.Fee,
+	}
+	if err := s.repo.UpsertOneWayFee(fee); err != nil {
+		return nil, fmt.Errorf("failed to save one-way fee: %w", err)
+	}
+	return s.repo.FindOneWayFee(req.FromBranchID, req.ToBranchID)
+}
+
+// GetOneWayFees implements Service.
+func (s *service) GetOneWayFees(filter *OneWayFeeFilter) ([]*OneWayFee, error) {
+	fees, err := s.repo.FindOneWayFees(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve one-way fees: %w", err)
+	}
+	return fees, nil
+}
+
+// DeleteOneWayFee implements Service.
+func (s *service) DeleteOneWayFee(id uint) error {
+	fee, err := s.repo.FindOneWayFeeByID(id)
+	if err != nil {
+		return errors.New("one-way fee not found")
+	}
+	return s.repo.DeleteOneWayFee(fee)
+}
+
+// CheckActive implements Service.
+func (s *service) CheckActive(tx *gorm.DB, id uint) (*Branch, error) {
+	branch, err := s.repo.WithTx(tx).FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("branch %d not found", id)
+	}
+	if !branch.Active {
+		return nil, fmt.Errorf("branch %s is not active", branch.Code)
+	}
+	return branch, nil
+}
+
+// OneWayFeeFor implements Service.
+// Pickup dan drop-off di branch yang sama, atau rute tanpa tarif, = 0.
+func (s *service) OneWayFeeFor(tx *gorm.DB, fromID, toID uint) (float64, error) {
+	if fromID == toID {
+		return 0, nil
+	}
+	fee, err := s.repo.WithTx(tx).FindOneWayFee(fromID, toID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return fee.Fee, nil
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo: repo,
+	}
+}
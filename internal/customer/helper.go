@@ -1,5 +1,11 @@
 package customer
 
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
 func ToCustomerResponse(customer *Customer) *CustomerResponse {
 	return &CustomerResponse{
 		ID:      customer.ID,
@@ -9,4 +15,36 @@ func ToCustomerResponse(customer *Customer) *CustomerResponse {
 		Address: customer.Address,
 		IDCard:  customer.IDCard,
 	}
-}
\ No newline at end of file
+}
+
+// ValidateIDCard memvalidasi format NIK KTP: 16 digit, kode wilayah tidak nol,
+// tanggal lahir valid (tanggal +40 untuk perempuan) dan nomor urut bukan 0000.
+func ValidateIDCard(idCard string) error {
+	if len(idCard) != 16 {
+		return errors.New("id card must be 16 digits")
+	}
+	for _, ch := range idCard {
+		if ch < '0' || ch > '9' {
+			return errors.New("id card must contain digits only")
+		}
+	}
+	if idCard[0:2] == "00" || idCard[2:4] == "00" || idCard[4:6] == "00" {
+		return errors.New("id card has an invalid region code")
+	}
+
+	day, _ := strconv.Atoi(idCard[6:8])
+	if day > 40 {
+		day -= 40
+	}
+	month, _ := strconv.Atoi(idCard[8:10])
+	year, _ := strconv.Atoi(idCard[10:12])
+	birth := time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if day < 1 || month < 1 || month > 12 || birth.Day() != day {
+		return errors.New("id card has an invalid birth date")
+	}
+
+	if idCard[12:16] == "0000" {
+		return errors.New("id card has an invalid serial number")
+	}
+	return nil
+}
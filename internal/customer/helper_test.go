@@ -0,0 +1,41 @@
+package customer
+
+import "testing"
+
+func TestValidateIDCard(t *testing.T) {
+	// Format NIK: PP KK CC (wilayah) DD MM YY (tanggal lahir) SSSS (nomor urut)
+	tests := []struct {
+		name    string
+		idCard  string
+		wantErr bool
+	}{
+		{"valid male", "3201011505900001", false},
+		{"valid female day offset", "3201015505900001", false},
+		{"female first day of month", "3201014105900001", false},
+		{"leap day", "3201012902000001", false},
+		{"too short", "320101150590001", true},
+		{"too long", "32010115059000011", true},
+		{"non digit", "32010115059A0001", true},
+		{"zero province", "0001011505900001", true},
+		{"zero regency", "3200011505900001", true},
+		{"zero district", "3201001505900001", true},
+		{"zero day", "3201010005900001", true},
+		{"day after month end", "3201013104900001", true},
+		{"day 32", "3201013205900001", true},
+		{"day 40 is not female offset", "3201014005900001", true},
+		{"female day 32", "3201017205900001", true},
+		{"zero month", "3201011500900001", true},
+		{"month 13", "3201011513900001", true},
+		{"leap day in non leap year", "3201012902010001", true},
+		{"zero serial", "3201011505900000", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIDCard(tt.idCard)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIDCard(%q) error = %v, wantErr %v", tt.idCard, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ type Repository interface {
 	FindByID(id uint) (*Customer, error)
 	FindAll(filter *CustomerFilter) ([]*Customer, error)
 	Update(customer *Customer) error
+	WithTx(tx *gorm.DB) Repository
 }
 
 type repository struct {
@@ -46,6 +47,11 @@ func (r *repository) Update(customer *Customer) error {
 	return r.db.Save(customer).Error
 }
 
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
 func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
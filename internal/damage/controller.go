@@ -0,0 +1,228 @@
+package damage
+
+import (
+	"go-rental/pkg/response"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateReport godoc
+// @Summary Create damage report
+// @Description Open a damage report against a vehicle, optionally tied to a rent and charged to it
+// @Tags Damage
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id formData int true "Vehicle ID"
+// @Param rent_id formData int false "Rent ID"
+// @Param severity formData string true "minor, moderate or severe"
+// @Param description formData string true "Description"
+// @Param repair_estimate formData number false "Repair estimate"
+// @Param charge_customer formData bool false "Charge the rent"
+// @Param charge_amount formData number false "Charged amount (default: repair estimate)"
+// @Param set_maintenance formData bool false "Put vehicle into maintenance (default: true for severe)"
+// @Param photos formData file false "Photos (jpg/png), may be repeated"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/damage/ [post]
+func (ctrl *Controller) CreateReport(c *gin.Context) {
+	var req DamageRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	// Foto opsional, form tanpa file tetap valid
+	var photos []*multipart.FileHeader
+	if form, err := c.MultipartForm(); err == nil {
+		photos = form.File["photos"]
+	}
+
+	report, err := ctrl.service.CreateReport(&req, photos, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "damage report created successfully", report)
+}
+
+// GetReports godoc
+// @Summary Get damage reports
+// @Description Retrieve damage reports, filterable by vehicle, customer, rent, severity and status
+// @Tags Damage
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param customer_id query int false "Customer ID"
+// @Param rent_id query int false "Rent ID"
+// @Param severity query string false "minor, moderate or severe"
+// @Param status query string false "open or resolved"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/damage/ [get]
+func (ctrl *Controller) GetReports(c *gin.Context) {
+	var filter DamageFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+	ctrl.respondReports(c, &filter)
+}
+
+// GetVehicleDamages godoc
+// @Summary Get vehicle damage history
+// @Description Retrieve all damage reports of a vehicle
+// @Tags Damage
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/damages [get]
+func (ctrl *Controller) GetVehicleDamages(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID format")
+		return
+	}
+	id := uint(vehicleID)
+	ctrl.respondReports(c, &DamageFilter{VehicleID: &id})
+}
+
+// GetCustomerDamages godoc
+// @Summary Get customer damage history
+// @Description Retrieve all damage reports linked to a customer's rents
+// @Tags Damage
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Customer ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/customer/{id}/damages [get]
+func (ctrl *Controller) GetCustomerDamages(c *gin.Context) {
+	customerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	id := uint(customerID)
+	ctrl.respondReports(c, &DamageFilter{CustomerID: &id})
+}
+
+func (ctrl *Controller) respondReports(c *gin.Context, filter *DamageFilter) {
+	reports, err := ctrl.service.GetAllReports(filter)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "damage reports retrieved successfully", reports)
+}
+
+// GetReportByID godoc
+// @Summary Get damage report by ID
+// @Description Retrieve a damage report by its ID
+// @Tags Damage
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Damage report ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/damage/{id} [get]
+func (ctrl *Controller) GetReportByID(c *gin.Context) {
+	reportID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid damage report ID")
+		return
+	}
+	report, err := ctrl.service.GetReportByID(uint(reportID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "damage report retrieved successfully", report)
+}
+
+// GetDamagePhoto godoc
+// @Summary Get damage photo
+// @Description Download a photo attached to a damage report
+// @Tags Damage
+// @Produce image/jpeg
+// @Produce image/png
+// @Security BearerAuth
+// @Param id path int true "Damage report ID"
+// @Param photo_id path int true "Damage photo ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/damage/{id}/photos/{photo_id} [get]
+func (ctrl *Controller) GetDamagePhoto(c *gin.Context) {
+	reportID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid damage report ID")
+		return
+	}
+	photoID, err := strconv.ParseUint(c.Param("photo_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid photo ID")
+		return
+	}
+
+	body, err := ctrl.service.GetPhoto(uint(reportID), uint(photoID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	c.Data(http.StatusOK, http.DetectContentType(body), body)
+}
+
+// UpdateReport godoc
+// @Summary Update damage report
+// @Description Update status, repair estimate or notes of a damage report
+// @Tags Damage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Damage report ID"
+// @Param data body UpdateDamageRequest true "Damage report update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/damage/{id} [put]
+func (ctrl *Controller) UpdateReport(c *gin.Context) {
+	reportID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid damage report ID")
+		return
+	}
+	var req UpdateDamageRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	report, err := ctrl.service.UpdateReport(uint(reportID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "damage report updated successfully", report)
+}
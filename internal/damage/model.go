@@ -0,0 +1,88 @@
+package damage
+
+import (
+	"encoding/json"
+	"go-rental/internal/customer"
+	"go-rental/internal/user"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"time"
+)
+
+type Severity string
+type ReportStatus string
+
+const (
+	SeverityMinor    Severity = "minor"
+	SeverityModerate Severity = "moderate"
+	SeveritySevere   Severity = "severe"
+)
+
+const (
+	StatusOpen     ReportStatus = "open"
+	StatusResolved ReportStatus = "resolved"
+)
+
+// DamageReport mencatat kerusakan kendaraan, opsional terkait rent
+// tempat kerusakan terjadi (dan customer penyewanya).
+type DamageReport struct {
+	ID             uint         `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleID      uint         `json:"vehicle_id" gorm:"index"`
+	RentID         *uint        `json:"rent_id" gorm:"index;default:null"`
+	CustomerID     *uint        `json:"customer_id" gorm:"index;default:null"`
+	Severity       Severity     `json:"severity" gorm:"type:enum('minor', 'moderate', 'severe')"`
+	Description    string       `json:"description"`
+	RepairEstimate float64      `json:"repair_estimate"`
+	ChargedAmount  float64      `json:"charged_amount"` // Jumlah yang ditagihkan ke rent, 0 jika tidak ditagih
+	Status         ReportStatus `json:"status" gorm:"type:enum('open', 'resolved');default:'open'"`
+	Notes          string       `json:"notes"`
+	ReportedByID   uint         `json:"reported_by_id"`
+	ReportedAt     time.Time    `json:"reported_at"`
+	ResolvedAt     *time.Time   `json:"resolved_at" gorm:"default:null"`
+
+	// Relations
+	Photos     []DamagePhoto      `json:"photos" gorm:"foreignKey:DamageReportID"`
+	Vehicle    vehicle.Vehicle    `json:"vehicle" gorm:"foreignKey:VehicleID"`
+	Customer   *customer.Customer `json:"customer,omitempty" gorm:"foreignKey:CustomerID"`
+	ReportedBy user.User          `json:"reported_by" gorm:"foreignKey:ReportedByID"`
+}
+
+// MarshalJSON menampilkan waktu DamageReport dalam zona bisnis
+func (d DamageReport) MarshalJSON() ([]byte, error) {
+	type alias DamageReport
+	d.ReportedAt = clock.In(d.ReportedAt)
+	d.ResolvedAt = clock.InPtr(d.ResolvedAt)
+	return json.Marshal(alias(d))
+}
+
+type DamagePhoto struct {
+	ID             uint   `json:"id" gorm:"primaryKey;autoIncrement"`
+	DamageReportID uint   `json:"damage_report_id" gorm:"index"`
+	Path           string `json:"path"` // Path relatif terhadap UploadDir, diunduh lewat GET /api/damage/:id/photos/:photo_id
+}
+
+type DamageRequest struct {
+	VehicleID      uint     `form:"vehicle_id" binding:"required"`
+	RentID         *uint    `form:"rent_id" binding:"omitempty"`
+	Severity       string   `form:"severity" binding:"required,oneof=minor moderate severe"`
+	Description    string   `form:"description" binding:"required"`
+	RepairEstimate float64  `form:"repair_estimate" binding:"min=0"`
+	ChargeCustomer bool     `form:"charge_customer"`                         // Tagihkan ke rent (butuh rent_id)
+	ChargeAmount   *float64 `form:"charge_amount" binding:"omitempty,min=0"` // Default = repair_estimate
+	SetMaintenance *bool    `form:"set_maintenance"`                         // Default true untuk severity severe
+	Notes          string   `form:"notes"`
+}
+
+type UpdateDamageRequest struct {
+	Status         *string  `json:"status" form:"status" binding:"omitempty,oneof=open resolved"`
+	RepairEstimate *float64 `json:"repair_estimate" form:"repair_estimate" binding:"omitempty,min=0"`
+	Notes          *string  `json:"notes" form:"notes" binding:"omitempty"`
+}
+
+type DamageFilter struct {
+	VehicleID  *uint   `form:"vehicle_id"`
+	CustomerID *uint   `form:"customer_id"`
+	RentID     *uint   `form:"rent_id"`
+	Severity   *string `form:"severity"`
+	Status     *string `form:"status"`
+}
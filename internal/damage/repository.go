@@ -0,0 +1,73 @@
+package damage
+
+import "gorm.io/gorm"
+
+type Repository interface {
+	Create(report *DamageReport) error
+	FindByID(id uint) (*DamageReport, error)
+	FindAll(filter *DamageFilter) ([]*DamageReport, error)
+	Update(report *DamageReport) error
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(report *DamageReport) error {
+	return r.db.Create(report).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*DamageReport, error) {
+	var report DamageReport
+	if err := r.db.Preload("Photos").Preload("Vehicle").Preload("Customer").Preload("ReportedBy").First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *DamageFilter) ([]*DamageReport, error) {
+	var reports []*DamageReport
+	query := r.db.Model(&DamageReport{}).Preload("Photos").Preload("Vehicle").Preload("Customer").Preload("ReportedBy")
+	// FILTER VEHICLE
+	if filter.VehicleID != nil {
+		query = query.Where("vehicle_id = ?", *filter.VehicleID)
+	}
+	// FILTER CUSTOMER
+	if filter.CustomerID != nil {
+		query = query.Where("customer_id = ?", *filter.CustomerID)
+	}
+	// FILTER RENT
+	if filter.RentID != nil {
+		query = query.Where("rent_id = ?", *filter.RentID)
+	}
+	// FILTER SEVERITY
+	if filter.Severity != nil {
+		query = query.Where("severity = ?", *filter.Severity)
+	}
+	// FILTER STATUS
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if err := query.Order("reported_at desc").Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(report *DamageReport) error {
+	return r.db.Omit("Vehicle", "Customer", "ReportedBy", "Photos").Save(report).Error
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,23 @@
+package damage
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupDamageRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	damage := r.Group("/api/damage")
+	{
+		damage.POST("/", middlewares.Authenticate(cfg), ctrl.CreateReport)
+		damage.GET("/", middlewares.Authenticate(cfg), ctrl.GetReports)
+		damage.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetReportByID)
+		damage.GET("/:id/photos/:photo_id", middlewares.Authenticate(cfg), ctrl.GetDamagePhoto)
+		damage.PUT("/:id", middlewares.Authenticate(cfg), ctrl.UpdateReport)
+	}
+
+	// Riwayat kerusakan per kendaraan dan per customer
+	r.GET("/api/vehicle/:id/damages", middlewares.Authenticate(cfg), ctrl.GetVehicleDamages)
+	r.GET("/api/customer/:id/damages", middlewares.Authenticate(cfg), ctrl.GetCustomerDamages)
+}
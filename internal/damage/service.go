@@ -0,0 +1,234 @@
+package damage
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/pricing"
+	"go-rental/internal/rent"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/config"
+	"go-rental/pkg/upload"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type Service interface {
+	CreateReport(req *DamageRequest, photos []*multipart.FileHeader, reportedBy uint) (*DamageReport, error)
+	GetReportByID(id uint) (*DamageReport, error)
+	GetAllReports(filter *DamageFilter) ([]*DamageReport, error)
+	UpdateReport(id uint, req *UpdateDamageRequest) (*DamageReport, error)
+	GetPhoto(reportID, photoID uint) ([]byte, error)
+}
+
+type service struct {
+	repo    Repository
+	rentUow rent.UnitOfWork
+	cfg     *config.Config
+}
+
+// CreateReport implements Service.
+func (s *service) CreateReport(req *DamageRequest, photos []*multipart.FileHeader, reportedBy uint) (*DamageReport, error) {
+	if req.ChargeCustomer && req.RentID == nil {
+		return nil, errors.New("rent_id is required to charge the customer")
+	}
+	for _, photo := range photos {
+		if !upload.IsImage(photo.Filename) {
+			return nil, fmt.Errorf("photo %s must be jpg or png", photo.Filename)
+		}
+	}
+
+	report := &DamageReport{
+		VehicleID:      req.VehicleID,
+		RentID:         req.RentID,
+		Severity:       Severity(req.Severity),
+		Description:    req.Description,
+		RepairEstimate: req.RepairEstimate,
+		Status:         StatusOpen,
+		Notes:          req.Notes,
+		ReportedByID:   reportedBy,
+		ReportedAt:     time.Now(),
+	}
+
+	// Maintenance otomatis untuk kerusakan berat, kecuali diminta lain
+	setMaintenance := report.Severity == SeveritySevere
+	if req.SetMaintenance != nil {
+		setMaintenance = *req.SetMaintenance
+	}
+
+	// Foto disimpan sebelum transaksi (tidak menahan lock selama upload)
+	// dan dihapus lagi jika laporan gagal disimpan
+	if err := s.savePhotos(report, photos); err != nil {
+		return nil, err
+	}
+
+	err := s.rentUow.Do(func(repos *rent.Repositories) error {
+		vh, err := repos.Vehicle.FindByIDForUpdate(req.VehicleID)
+		if err != nil {
+			return errors.New("vehicle not found")
+		}
+
+		// Rent harus untuk kendaraan yang sama, customer diambil dari rent
+		if req.RentID != nil {
+			rt, err := repos.Rent.FindByIDForUpdate(*req.RentID)
+			if err != nil {
+				return errors.New("rent not found")
+			}
+			if rt.VehicleID == nil || *rt.VehicleID != vh.ID {
+				return errors.New("rent does not belong to this vehicle")
+			}
+			customerID := rt.CustomerID
+			report.CustomerID = &customerID
+
+			// Tagihkan biaya kerusakan ke rent. Rent completed sudah punya
+			// invoice final, jadi hanya rent yang masih berjalan yang bisa ditagih.
+			if req.ChargeCustomer {
+				if rt.Status != rent.StatusReserved && rt.Status != rent.StatusOngoing {
+					return fmt.Errorf("cannot charge damage to %s rent, only reserved or ongoing rent can be charged", rt.Status)
+				}
+				amount := req.RepairEstimate
+				if req.ChargeAmount != nil {
+					amount = *req.ChargeAmount
+				}
+				if amount > 0 {
+					charge := &rent.RentCharge{
+						RentID:      rt.ID,
+						Type:        pricing.ChargeDamage,
+						Description: "Damage: " + req.Description,
+						Quantity:    1,
+						UnitPrice:   amount,
+						Amount:      amount,
+					}
+					if err := repos.Rent.CreateCharges([]*rent.RentCharge{charge}); err != nil {
+						return errors.New("failed to save rent charge")
+					}
+					if rt.TotalPrice, err = repos.Rent.SumCharges(rt.ID); err != nil {
+						return errors.New("failed to calculate total price")
+					}
+					if err := repos.Rent.Update(rt); err != nil {
+						return errors.New("failed to update rent")
+					}
+					report.ChargedAmount = amount
+				}
+			}
+		}
+
+		if setMaintenance && vh.Status != vehicle.StatusMaintenance {
+			vh.Status = vehicle.StatusMaintenance
+			if err := repos.Vehicle.Update(vh); err != nil {
+				return errors.New("failed to update vehicle status")
+			}
+		}
+
+		if err := s.repo.WithTx(repos.Tx).Create(report); err != nil {
+			return fmt.Errorf("failed to create damage report: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.removePhotos(report.Photos)
+		return nil, err
+	}
+
+	return s.repo.FindByID(report.ID)
+}
+
+// savePhotos menyimpan foto ke UploadDir/damages/<vehicle_id>/. Jika satu
+// foto gagal, foto yang sudah tersimpan dihapus lagi.
+func (s *service) savePhotos(report *DamageReport, photos []*multipart.FileHeader) error {
+	for i, photo := range photos {
+		name := fmt.Sprintf("%d-%d%s", time.Now().Unix(), i+1, strings.ToLower(filepath.Ext(photo.Filename)))
+		relPath := filepath.ToSlash(filepath.Join("damages", fmt.Sprint(report.VehicleID), name))
+		if err := upload.Save(photo, filepath.Join(s.cfg.UploadDir, relPath)); err != nil {
+			s.removePhotos(report.Photos)
+			report.Photos = nil
+			return fmt.Errorf("failed to save photo: %w", err)
+		}
+		report.Photos = append(report.Photos, DamagePhoto{Path: relPath})
+	}
+	return nil
+}
+
+// removePhotos menghapus file foto yang tidak jadi dipakai
+func (s *service) removePhotos(photos []DamagePhoto) {
+	for _, photo := range photos {
+		os.Remove(filepath.Join(s.cfg.UploadDir, filepath.FromSlash(photo.Path)))
+	}
+}
+
+// GetReportByID implements Service.
+func (s *service) GetReportByID(id uint) (*DamageReport, error) {
+	report, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("damage report not found: %w", err)
+	}
+	return report, nil
+}
+
+// GetPhoto implements Service.
+// Foto kerusakan adalah bukti klaim, jadi tidak disajikan sebagai static file.
+func (s *service) GetPhoto(reportID, photoID uint) ([]byte, error) {
+	report, err := s.repo.FindByID(reportID)
+	if err != nil {
+		return nil, fmt.Errorf("damage report not found: %w", err)
+	}
+	for _, photo := range report.Photos {
+		if photo.ID != photoID {
+			continue
+		}
+		body, err := os.ReadFile(filepath.Join(s.cfg.UploadDir, filepath.FromSlash(photo.Path)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read photo: %w", err)
+		}
+		return body, nil
+	}
+	return nil, errors.New("photo not found")
+}
+
+// GetAllReports implements Service.
+func (s *service) GetAllReports(filter *DamageFilter) ([]*DamageReport, error) {
+	reports, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve damage reports: %w", err)
+	}
+	return reports, nil
+}
+
+// UpdateReport implements Service.
+func (s *service) UpdateReport(id uint, req *UpdateDamageRequest) (*DamageReport, error) {
+	report, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("damage report not found: %w", err)
+	}
+
+	if req.Status != nil {
+		report.Status = ReportStatus(*req.Status)
+		if report.Status == StatusResolved {
+			now := time.Now()
+			report.ResolvedAt = &now
+		} else {
+			report.ResolvedAt = nil
+		}
+	}
+	if req.RepairEstimate != nil {
+		report.RepairEstimate = *req.RepairEstimate
+	}
+	if req.Notes != nil {
+		report.Notes = *req.Notes
+	}
+
+	if err := s.repo.Update(report); err != nil {
+		return nil, fmt.Errorf("failed to update damage report: %w", err)
+	}
+	return report, nil
+}
+
+func NewService(repo Repository, rentUow rent.UnitOfWork, cfg *config.Config) Service {
+	return &service{
+		repo:    repo,
+		rentUow: rentUow,
+		cfg:     cfg,
+	}
+}
@@ -0,0 +1,187 @@
+package document
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateDocument godoc
+// @Summary Create vehicle document
+// @Description Record a registration, insurance or tax document with its expiry date. Renewals are recorded as new documents
+// @Tags Vehicle Document
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body DocumentRequest true "Document data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/document/ [post]
+func (ctrl *Controller) CreateDocument(c *gin.Context) {
+	var req DocumentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	doc, err := ctrl.service.CreateDocument(&req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "vehicle document created successfully", doc)
+}
+
+// GetDocuments godoc
+// @Summary Get vehicle documents
+// @Description Retrieve vehicle documents including renewal history, optionally filtered by vehicle or type
+// @Tags Vehicle Document
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param type query string false "registration, insurance or tax"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/document/ [get]
+func (ctrl *Controller) GetDocuments(c *gin.Context) {
+	var filter DocumentFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	docs, err := ctrl.service.GetAllDocuments(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle documents retrieved successfully", docs)
+}
+
+// GetExpiring godoc
+// @Summary Get expiring vehicle documents
+// @Description List current documents that are expired or expire within N days (default 30), soonest first
+// @Tags Vehicle Document
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param type query string false "registration, insurance or tax"
+// @Param within_days query int false "Expiring within N days"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/document/expiring [get]
+func (ctrl *Controller) GetExpiring(c *gin.Context) {
+	var filter ExpiringFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	docs, err := ctrl.service.GetExpiring(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "expiring vehicle documents retrieved successfully", docs)
+}
+
+// GetDocumentByID godoc
+// @Summary Get vehicle document by ID
+// @Description Retrieve a vehicle document by its ID
+// @Tags Vehicle Document
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Document ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/document/{id} [get]
+func (ctrl *Controller) GetDocumentByID(c *gin.Context) {
+	docID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	doc, err := ctrl.service.GetDocumentByID(uint(docID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle document retrieved successfully", doc)
+}
+
+// UpdateDocument godoc
+// @Summary Update vehicle document
+// @Description Correct the number, dates or mandatory flag of a vehicle document
+// @Tags Vehicle Document
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Document ID"
+// @Param data body UpdateDocumentRequest true "Document update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/document/{id} [put]
+func (ctrl *Controller) UpdateDocument(c *gin.Context) {
+	docID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	var req UpdateDocumentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	doc, err := ctrl.service.UpdateDocument(uint(docID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle document updated successfully", doc)
+}
+
+// DeleteDocument godoc
+// @Summary Delete vehicle document
+// @Description Delete a vehicle document recorded by mistake
+// @Tags Vehicle Document
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Document ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/document/{id} [delete]
+func (ctrl *Controller) DeleteDocument(c *gin.Context) {
+	docID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	if err := ctrl.service.DeleteDocument(uint(docID)); err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle document deleted successfully", nil)
+}
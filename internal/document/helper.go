@@ -0,0 +1,58 @@
+package document
+
+import (
+	"go-rental/pkg/clock"
+	"time"
+)
+
+// daysUntil menghitung selisih tanggal kalender (zona waktu bisnis) dari
+// now ke t. Hari yang sama = 0, kemarin = -1.
+func daysUntil(now, t time.Time) int {
+	from, _ := time.Parse(time.DateOnly, clock.Date(now))
+	to, _ := time.Parse(time.DateOnly, clock.Date(t))
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// validUntil adalah batas berlaku dokumen: awal hari setelah tanggal
+// ExpiresAt (zona waktu bisnis), karena dokumen berlaku sampai akhir tanggal itu
+func validUntil(expiresAt time.Time) time.Time {
+	t := expiresAt.In(clock.Location())
+	return clock.StartOfDay(time.Date(t.Year(), t.Month(), t.Day()+1, 12, 0, 0, 0, clock.Location()))
+}
+
+// evaluateExpiry: dokumen expired mulai hari setelah tanggal ExpiresAt
+func evaluateExpiry(doc *Document, now time.Time, withinDays int) *ExpiryResponse {
+	resp := &ExpiryResponse{
+		Document:   doc,
+		Status:     ExpiryValid,
+		ExpiryDate: clock.Date(doc.ExpiresAt),
+		DaysLeft:   daysUntil(now, doc.ExpiresAt),
+	}
+	switch {
+	case resp.DaysLeft < 0:
+		resp.Status = ExpiryExpired
+	case resp.DaysLeft <= withinDays:
+		resp.Status = ExpirySoon
+	}
+	return resp
+}
+
+// currentDocuments memilih dokumen yang berlaku (ExpiresAt paling akhir)
+// per kendaraan per jenis. docs harus urut vehicle_id, type, expires_at desc.
+func currentDocuments(docs []*Document) []*Document {
+	type key struct {
+		vehicleID uint
+		docType   DocumentType
+	}
+	seen := make(map[key]bool)
+	current := make([]*Document, 0, len(docs))
+	for _, doc := range docs {
+		k := key{doc.VehicleID, doc.Type}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		current = append(current, doc)
+	}
+	return current
+}
@@ -0,0 +1,98 @@
+package document
+
+import (
+	"encoding/json"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"time"
+)
+
+type DocumentType string
+type ExpiryStatus string
+
+const (
+	TypeRegistration DocumentType = "registration" // STNK
+	TypeInsurance    DocumentType = "insurance"    // polis asuransi
+	TypeTax          DocumentType = "tax"          // pajak kendaraan
+)
+
+const (
+	ExpiryValid   ExpiryStatus = "valid"
+	ExpirySoon    ExpiryStatus = "expiring"
+	ExpiryExpired ExpiryStatus = "expired"
+)
+
+// Document adalah dokumen legal kendaraan. Perpanjangan dicatat sebagai
+// dokumen baru, dokumen yang berlaku untuk satu jenis adalah yang
+// ExpiresAt-nya paling akhir. Dokumen masih berlaku sampai akhir
+// tanggal ExpiresAt (zona waktu bisnis).
+type Document struct {
+	ID          uint         `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleID   uint         `json:"vehicle_id" gorm:"index"`
+	Type        DocumentType `json:"type" gorm:"type:enum('registration', 'insurance', 'tax')"`
+	Number      string       `json:"number"`
+	Issuer      string       `json:"issuer"` // contoh: Samsat, nama perusahaan asuransi
+	IssuedAt    *time.Time   `json:"issued_at" gorm:"default:null"`
+	ExpiresAt   time.Time    `json:"expires_at" gorm:"index"`
+	Mandatory   bool         `json:"mandatory"` // wajib berlaku agar kendaraan bisa disewa
+	Notes       string       `json:"notes"`
+	CreatedByID uint         `json:"created_by_id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+
+	Vehicle vehicle.Vehicle `json:"vehicle" gorm:"foreignKey:VehicleID"`
+}
+
+// MarshalJSON menampilkan waktu Document dalam zona bisnis
+func (d Document) MarshalJSON() ([]byte, error) {
+	type alias Document
+	d.ExpiresAt = clock.In(d.ExpiresAt)
+	d.CreatedAt = clock.In(d.CreatedAt)
+	d.UpdatedAt = clock.In(d.UpdatedAt)
+	d.IssuedAt = clock.InPtr(d.IssuedAt)
+	return json.Marshal(alias(d))
+}
+
+func (Document) TableName() string {
+	return "vehicle_documents"
+}
+
+type DocumentRequest struct {
+	VehicleID uint       `json:"vehicle_id" form:"vehicle_id" binding:"required"`
+	Type      string     `json:"type" form:"type" binding:"required,oneof=registration insurance tax"`
+	Number    string     `json:"number" form:"number" binding:"required"`
+	Issuer    string     `json:"issuer" form:"issuer"`
+	IssuedAt  *time.Time `json:"issued_at" form:"issued_at"`
+	ExpiresAt time.Time  `json:"expires_at" form:"expires_at" binding:"required"`
+	Mandatory *bool      `json:"mandatory" form:"mandatory"` // default: true
+	Notes     string     `json:"notes" form:"notes"`
+}
+
+type UpdateDocumentRequest struct {
+	Number    *string    `json:"number" form:"number" binding:"omitempty"`
+	Issuer    *string    `json:"issuer" form:"issuer" binding:"omitempty"`
+	IssuedAt  *time.Time `json:"issued_at" form:"issued_at" binding:"omitempty"`
+	ExpiresAt *time.Time `json:"expires_at" form:"expires_at" binding:"omitempty"`
+	Mandatory *bool      `json:"mandatory" form:"mandatory" binding:"omitempty"`
+	Notes     *string    `json:"notes" form:"notes" binding:"omitempty"`
+}
+
+type DocumentFilter struct {
+	VehicleID *uint   `form:"vehicle_id"`
+	Type      *string `form:"type" binding:"omitempty,oneof=registration insurance tax"`
+}
+
+// ExpiringFilter: dokumen yang berlaku dianggap "expiring" jika habis
+// dalam WithinDays hari ke depan. Default 30 hari.
+type ExpiringFilter struct {
+	VehicleID  *uint   `form:"vehicle_id"`
+	Type       *string `form:"type" binding:"omitempty,oneof=registration insurance tax"`
+	WithinDays *int    `form:"within_days" binding:"omitempty,min=0"`
+}
+
+type ExpiryResponse struct {
+	Document   *Document    `json:"document"`
+	Status     ExpiryStatus `json:"status"`
+	ExpiryDate string       `json:"expiry_date"` // YYYY-MM-DD
+	DaysLeft   int          `json:"days_left"`   // negatif jika sudah lewat
+}
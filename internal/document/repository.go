@@ -0,0 +1,94 @@
+package document
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	Create(doc *Document) error
+	FindByID(id uint) (*Document, error)
+	FindAll(filter *DocumentFilter) ([]*Document, error)
+	Update(doc *Document) error
+	Delete(doc *Document) error
+	// LatestMandatoryExpiry mengembalikan ExpiresAt terakhir per jenis
+	// dokumen wajib milik kendaraan
+	LatestMandatoryExpiry(vehicleID uint) (map[DocumentType]time.Time, error)
+
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(doc *Document) error {
+	return r.db.Omit("Vehicle").Create(doc).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Document, error) {
+	var doc Document
+	if err := r.db.Preload("Vehicle").First(&doc, id).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *DocumentFilter) ([]*Document, error) {
+	var docs []*Document
+	query := r.db.Preload("Vehicle").Model(&Document{})
+	if filter.VehicleID != nil {
+		query = query.Where("vehicle_id = ?", *filter.VehicleID)
+	}
+	if filter.Type != nil {
+		query = query.Where("type = ?", *filter.Type)
+	}
+	if err := query.Order("vehicle_id asc, type asc, expires_at desc").Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(doc *Document) error {
+	return r.db.Omit("Vehicle").Save(doc).Error
+}
+
+// Delete implements Repository.
+func (r *repository) Delete(doc *Document) error {
+	return r.db.Delete(doc).Error
+}
+
+// LatestMandatoryExpiry implements Repository.
+func (r *repository) LatestMandatoryExpiry(vehicleID uint) (map[DocumentType]time.Time, error) {
+	var rows []struct {
+		Type      DocumentType
+		ExpiresAt time.Time
+	}
+	err := r.db.Model(&Document{}).
+		Select("type, MAX(expires_at) AS expires_at").
+		Where("vehicle_id = ? AND mandatory = ?", vehicleID, true).
+		Group("type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[DocumentType]time.Time, len(rows))
+	for _, row := range rows {
+		result[row.Type] = row.ExpiresAt
+	}
+	return result, nil
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
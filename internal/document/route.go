@@ -0,0 +1,20 @@
+package document
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupDocumentRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	document := r.Group("/api/document")
+	{
+		document.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateDocument)
+		document.GET("/", middlewares.Authenticate(cfg), ctrl.GetDocuments)
+		document.GET("/expiring", middlewares.Authenticate(cfg), ctrl.GetExpiring)
+		document.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetDocumentByID)
+		document.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateDocument)
+		document.DELETE("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteDocument)
+	}
+}
@@ -0,0 +1,197 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"go-rental/pkg/config"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreateDocument(req *DocumentRequest, createdBy uint) (*Document, error)
+	GetAllDocuments(filter *DocumentFilter) ([]*Document, error)
+	GetDocumentByID(id uint) (*Document, error)
+	UpdateDocument(id uint, req *UpdateDocumentRequest) (*Document, error)
+	DeleteDocument(id uint) error
+	GetExpiring(filter *ExpiringFilter) ([]*ExpiryResponse, error)
+
+	// Dipanggil dari transaksi rent
+	CheckValid(tx *gorm.DB, vehicleID uint, start time.Time, end *time.Time) error
+}
+
+type service struct {
+	repo        Repository
+	vehicleRepo vehicle.Repository
+	cfg         *config.Config
+}
+
+// CreateDocument implements Service.
+// Perpanjangan dokumen dicatat sebagai dokumen baru agar riwayatnya tetap ada.
+func (s *service) CreateDocument(req *DocumentRequest, createdBy uint) (*Document, error) {
+	vh, err := s.vehicleRepo.FindByID(req.VehicleID)
+	if err != nil {
+		return nil, errors.New("vehicle not found")
+	}
+	if req.IssuedAt != nil && req.ExpiresAt.Before(*req.IssuedAt) {
+		return nil, errors.New("expires_at must be after issued_at")
+	}
+
+	doc := &Document{
+		VehicleID:   vh.ID,
+		Type:        DocumentType(req.Type),
+		Number:      req.Number,
+		Issuer:      req.Issuer,
+		IssuedAt:    req.IssuedAt,
+		ExpiresAt:   req.ExpiresAt,
+		Mandatory:   true,
+		Notes:       req.Notes,
+		CreatedByID: createdBy,
+	}
+	if req.Mandatory != nil {
+		doc.Mandatory = *req.Mandatory
+	}
+	if err := s.repo.Create(doc); err != nil {
+		return nil, fmt.Errorf("failed to create vehicle document: %w", err)
+	}
+	return s.repo.FindByID(doc.ID)
+}
+
+// GetAllDocuments implements Service.
+func (s *service) GetAllDocuments(filter *DocumentFilter) ([]*Document, error) {
+	docs, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve vehicle documents: %w", err)
+	}
+	return docs, nil
+}
+
+// GetDocumentByID implements Service.
+func (s *service) GetDocumentByID(id uint) (*Document, error) {
+	doc, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("vehicle document not found")
+	}
+	return doc, nil
+}
+
+// UpdateDocument implements Service.
+func (s *service) UpdateDocument(id uint, req *UpdateDocumentRequest) (*Document, error) {
+	doc, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("vehicle document not found")
+	}
+
+	// Update only fields that are not nil
+	if req.Number != nil {
+		doc.Number = *req.Number
+	}
+	if req.Issuer != nil {
+		doc.Issuer = *req.Issuer
+	}
+	if req.IssuedAt != nil {
+		doc.IssuedAt = req.IssuedAt
+	}
+	if req.ExpiresAt != nil {
+		doc.ExpiresAt = *req.ExpiresAt
+	}
+	if req.Mandatory != nil {
+		doc.Mandatory = *req.Mandatory
+	}
+	if req.Notes != nil {
+		doc.Notes = *req.Notes
+	}
+	if doc.IssuedAt != nil && doc.ExpiresAt.Before(*doc.IssuedAt) {
+		return nil, errors.New("expires_at must be after issued_at")
+	}
+
+	if err := s.repo.Update(doc); err != nil {
+		return nil, fmt.Errorf("failed to update vehicle document: %w", err)
+	}
+	return doc, nil
+}
+
+// DeleteDocument implements Service.
+func (s *service) DeleteDocument(id uint) error {
+	doc, err := s.repo.FindByID(id)
+	if err != nil {
+		return errors.New("vehicle document not found")
+	}
+	return s.repo.Delete(doc)
+}
+
+// GetExpiring implements Service.
+// Hanya dokumen yang berlaku per kendaraan per jenis; dokumen lama yang
+// sudah diperpanjang tidak ikut dilaporkan. Urut dari yang paling dulu habis.
+func (s *service) GetExpiring(filter *ExpiringFilter) ([]*ExpiryResponse, error) {
+	withinDays := 30
+	if filter.WithinDays != nil {
+		withinDays = *filter.WithinDays
+	}
+
+	docs, err := s.repo.FindAll(&DocumentFilter{VehicleID: filter.VehicleID, Type: filter.Type})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve vehicle documents: %w", err)
+	}
+
+	now := time.Now()
+	result := []*ExpiryResponse{}
+	for _, doc := range currentDocuments(docs) {
+		resp := evaluateExpiry(doc, now, withinDays)
+		if resp.Status != ExpiryValid {
+			result = append(result, resp)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Document.ExpiresAt.Before(result[j].Document.ExpiresAt)
+	})
+	return result, nil
+}
+
+// CheckValid implements Service.
+// Menolak jika ada dokumen wajib yang habis sebelum periode [start, end)
+// selesai. Rent tanpa expected return (end nil) hanya dicek pada start.
+// Kendaraan tanpa catatan dokumen wajib untuk suatu jenis diatur oleh
+// MISSING_DOCUMENT_POLICY: allow (default) = boleh disewa, block = ditolak.
+func (s *service) CheckValid(tx *gorm.DB, vehicleID uint, start time.Time, end *time.Time) error {
+	latest, err := s.repo.WithTx(tx).LatestMandatoryExpiry(vehicleID)
+	if err != nil {
+		return err
+	}
+
+	var missing, expired []string
+	for _, docType := range []DocumentType{TypeRegistration, TypeInsurance, TypeTax} {
+		expiresAt, ok := latest[docType]
+		if !ok {
+			missing = append(missing, string(docType))
+			continue
+		}
+		until := validUntil(expiresAt)
+		switch {
+		case !until.After(start):
+			expired = append(expired, fmt.Sprintf("%s (expired %s)", docType, clock.Date(expiresAt)))
+		case end != nil && until.Before(*end):
+			expired = append(expired, fmt.Sprintf("%s (expires %s, before the rent ends)", docType, clock.Date(expiresAt)))
+		}
+	}
+	if len(expired) > 0 {
+		return fmt.Errorf("vehicle has expired mandatory documents: %s", strings.Join(expired, ", "))
+	}
+	if len(missing) > 0 && s.cfg.MissingDocumentPolicy == "block" {
+		return fmt.Errorf("vehicle has no mandatory documents on record: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func NewService(repo Repository, vehicleRepo vehicle.Repository, cfg *config.Config) Service {
+	return &service{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+		cfg:         cfg,
+	}
+}
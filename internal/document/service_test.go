@@ -0,0 +1,61 @@
+package document
+
+import (
+	"go-rental/pkg/config"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// fakeRepo hanya menyediakan LatestMandatoryExpiry untuk CheckValid
+type fakeRepo struct {
+	Repository
+	latest map[DocumentType]time.Time
+}
+
+func (r fakeRepo) LatestMandatoryExpiry(uint) (map[DocumentType]time.Time, error) {
+	return r.latest, nil
+}
+
+func (r fakeRepo) WithTx(*gorm.DB) Repository {
+	return r
+}
+
+func TestCheckValid(t *testing.T) {
+	// Zona bisnis default UTC, dokumen berlaku sampai akhir 2026-05-10
+	expires := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	all := map[DocumentType]time.Time{TypeRegistration: expires, TypeInsurance: expires, TypeTax: expires}
+	onlyTax := map[DocumentType]time.Time{TypeTax: expires}
+	at := func(day, hour int) time.Time { return time.Date(2026, 5, day, hour, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name    string
+		latest  map[DocumentType]time.Time
+		policy  string
+		start   time.Time
+		end     *time.Time
+		wantErr bool
+	}{
+		{"period inside validity", all, "allow", at(8, 9), ptr(at(10, 18)), false},
+		{"ends exactly when validity ends", all, "allow", at(8, 9), ptr(at(11, 0)), false},
+		{"expires during the period", all, "allow", at(8, 9), ptr(at(12, 9)), true},
+		{"already expired at start", all, "allow", at(11, 0), ptr(at(12, 9)), true},
+		{"open ended checks start only", all, "allow", at(10, 9), nil, false},
+		{"no documents allowed by default", nil, "allow", at(8, 9), ptr(at(12, 9)), false},
+		{"no documents blocked by policy", nil, "block", at(8, 9), ptr(at(10, 9)), true},
+		{"missing type blocked by policy", onlyTax, "block", at(8, 9), ptr(at(10, 9)), true},
+		{"missing type allowed by default", onlyTax, "allow", at(8, 9), ptr(at(10, 9)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(fakeRepo{latest: tt.latest}, nil, &config.Config{MissingDocumentPolicy: tt.policy})
+			err := svc.CheckValid(nil, 1, tt.start, tt.end)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckValid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package extra
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateExtra godoc
+// @Summary Create extra
+// @Description Add a rental add-on (helmet, raincoat, child seat, GPS) to the catalogue
+// @Tags Extra
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body ExtraRequest true "Extra data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/extra/ [post]
+func (ctrl *Controller) CreateExtra(c *gin.Context) {
+	var req ExtraRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	extra, err := ctrl.service.CreateExtra(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "extra created successfully", extra)
+}
+
+// GetExtras godoc
+// @Summary Get extras
+// @Description Retrieve the extras catalogue, optionally filtered by vehicle type
+// @Tags Extra
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_type query string false "car or bike"
+// @Param active query bool false "Active only"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/extra/ [get]
+func (ctrl *Controller) GetExtras(c *gin.Context) {
+	var filter ExtraFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	extras, err := ctrl.service.GetAllExtras(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "extras retrieved successfully", extras)
+}
+
+// GetAvailableExtras godoc
+// @Summary Get extras availability
+// @Description Retrieve remaining stock of each extra for a period
+// @Tags Extra
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "Start of period (RFC3339)"
+// @Param to query string true "End of period (RFC3339)"
+// @Param vehicle_type query string false "car or bike"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/extra/available [get]
+func (ctrl *Controller) GetAvailableExtras(c *gin.Context) {
+	var filter AvailabilityFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	availability, err := ctrl.service.GetAvailability(&filter)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "extras availability retrieved successfully", availability)
+}
+
+// GetExtraByID godoc
+// @Summary Get extra by ID
+// @Description Retrieve an extra by its ID
+// @Tags Extra
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Extra ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/extra/{id} [get]
+func (ctrl *Controller) GetExtraByID(c *gin.Context) {
+	extraID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid extra ID")
+		return
+	}
+	extra, err := ctrl.service.GetExtraByID(uint(extraID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "extra retrieved successfully", extra)
+}
+
+// UpdateExtra godoc
+// @Summary Update extra
+// @Description Update price, stock or status of an extra
+// @Tags Extra
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Extra ID"
+// @Param data body UpdateExtraRequest true "Extra update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/extra/{id} [put]
+func (ctrl *Controller) UpdateExtra(c *gin.Context) {
+	extraID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid extra ID")
+		return
+	}
+	var req UpdateExtraRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	extra, err := ctrl.service.UpdateExtra(uint(extraID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "extra updated successfully", extra)
+}
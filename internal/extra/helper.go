@@ -0,0 +1,50 @@
+package extra
+
+import (
+	"fmt"
+	"go-rental/internal/pricing"
+	"go-rental/pkg/clock"
+	"math"
+	"sort"
+	"time"
+)
+
+// mergeItems menggabungkan extra yang sama dalam satu request lalu
+// mengurutkannya berdasarkan ExtraID. Row extra selalu dikunci dalam urutan
+// ID yang sama supaya dua reservasi paralel tidak saling deadlock.
+func mergeItems(items []ItemRequest) []ItemRequest {
+	index := make(map[uint]int, len(items))
+	merged := make([]ItemRequest, 0, len(items))
+	for _, item := range items {
+		if i, ok := index[item.ExtraID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.ExtraID] = len(merged)
+		merged = append(merged, item)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].ExtraID < merged[j].ExtraID
+	})
+	return merged
+}
+
+// toLineItem menghitung tagihan satu extra. per_day mengikuti hari tertagih
+// yang sama dengan sewa kendaraan (clock.BillingDays), flat sekali per rent.
+func toLineItem(item *RentExtra, start, end time.Time) pricing.LineItem {
+	quantity := float64(item.Quantity)
+	unit := item.UnitPrice
+	description := fmt.Sprintf("%s x%d", item.Extra.Name, item.Quantity)
+	if item.PriceType == PricePerDay {
+		days := float64(len(clock.BillingDays(start, end)))
+		quantity *= days
+		description = fmt.Sprintf("%s x%d, %.0f day", item.Extra.Name, item.Quantity, days)
+	}
+	return pricing.LineItem{
+		Type:        pricing.ChargeExtra,
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   unit,
+		Amount:      math.Round(quantity*unit*100) / 100,
+	}
+}
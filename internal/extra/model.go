@@ -0,0 +1,85 @@
+package extra
+
+import (
+	"go-rental/internal/vehicle"
+	"time"
+)
+
+type PriceType string
+
+const (
+	PricePerDay PriceType = "per_day"
+	PriceFlat   PriceType = "flat"
+)
+
+// Extra adalah item tambahan yang bisa disewa bersama kendaraan
+// (helm, jas hujan, child seat, GPS). VehicleType nil = untuk semua tipe.
+type Extra struct {
+	ID          uint                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	VehicleType *vehicle.VehicleType `json:"vehicle_type" gorm:"type:enum('car', 'bike');default:null"`
+	PriceType   PriceType            `json:"price_type" gorm:"type:enum('per_day', 'flat')"`
+	Price       float64              `json:"price"`
+	Stock       int                  `json:"stock"`
+	Active      bool                 `json:"active" gorm:"default:true"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// RentExtra adalah extra yang dipesan sebuah rent. Stok dianggap terpakai
+// selama periode rent selama rent masih reserved/ongoing.
+// Harga disalin saat dipesan supaya perubahan katalog tidak mengubah tagihan.
+type RentExtra struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID    uint      `json:"rent_id" gorm:"index"`
+	ExtraID   uint      `json:"extra_id" gorm:"index"`
+	Quantity  int       `json:"quantity"`
+	PriceType PriceType `json:"price_type" gorm:"type:enum('per_day', 'flat')"`
+	UnitPrice float64   `json:"unit_price"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Extra Extra `json:"extra" gorm:"foreignKey:ExtraID"`
+}
+
+// ItemRequest adalah extra yang diminta saat membuat rent
+type ItemRequest struct {
+	ExtraID  uint `json:"extra_id" form:"extra_id" binding:"required"`
+	Quantity int  `json:"quantity" form:"quantity" binding:"required,min=1"`
+}
+
+type ExtraRequest struct {
+	Name        string  `json:"name" form:"name" binding:"required"`
+	Description string  `json:"description" form:"description"`
+	VehicleType *string `json:"vehicle_type" form:"vehicle_type" binding:"omitempty,oneof=car bike"`
+	PriceType   string  `json:"price_type" form:"price_type" binding:"required,oneof=per_day flat"`
+	Price       float64 `json:"price" form:"price" binding:"min=0"`
+	Stock       int     `json:"stock" form:"stock" binding:"min=0"`
+}
+
+type UpdateExtraRequest struct {
+	Name        *string  `json:"name" form:"name" binding:"omitempty"`
+	Description *string  `json:"description" form:"description" binding:"omitempty"`
+	PriceType   *string  `json:"price_type" form:"price_type" binding:"omitempty,oneof=per_day flat"`
+	Price       *float64 `json:"price" form:"price" binding:"omitempty,min=0"`
+	Stock       *int     `json:"stock" form:"stock" binding:"omitempty,min=0"`
+	Active      *bool    `json:"active" form:"active" binding:"omitempty"`
+}
+
+type ExtraFilter struct {
+	VehicleType *string `form:"vehicle_type"`
+	Active      *bool   `form:"active"`
+}
+
+// AvailabilityFilter = ExtraFilter + periode yang dicek stoknya
+type AvailabilityFilter struct {
+	ExtraFilter
+	From time.Time `form:"from" binding:"required"`
+	To   time.Time `form:"to"   binding:"required"`
+}
+
+type AvailabilityResponse struct {
+	Extra     *Extra `json:"extra"`
+	Reserved  int    `json:"reserved"`
+	Available int    `json:"available"`
+}
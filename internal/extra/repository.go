@@ -0,0 +1,122 @@
+package extra
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Create(extra *Extra) error
+	FindByID(id uint) (*Extra, error)
+	FindByIDForUpdate(id uint) (*Extra, error)
+	FindAll(filter *ExtraFilter) ([]*Extra, error)
+	Update(extra *Extra) error
+
+	CreateRentExtras(items []*RentExtra) error
+	FindRentExtras(rentID uint) ([]*RentExtra, error)
+	ReservedQuantity(extraID uint, start time.Time, end *time.Time, excludeRentID uint) (int, error)
+
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(extra *Extra) error {
+	return r.db.Create(extra).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Extra, error) {
+	var extra Extra
+	if err := r.db.First(&extra, id).Error; err != nil {
+		return nil, err
+	}
+	return &extra, nil
+}
+
+// FindByIDForUpdate implements Repository.
+// Mengunci baris extra supaya reservasi stok paralel harus antre.
+func (r *repository) FindByIDForUpdate(id uint) (*Extra, error) {
+	var extra Extra
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&extra, id).Error; err != nil {
+		return nil, err
+	}
+	return &extra, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *ExtraFilter) ([]*Extra, error) {
+	var extras []*Extra
+	query := r.db.Model(&Extra{})
+	// Extra tanpa vehicle_type berlaku untuk semua tipe
+	if filter.VehicleType != nil {
+		query = query.Where("vehicle_type = ? OR vehicle_type IS NULL", *filter.VehicleType)
+	}
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+	if err := query.Order("id asc").Find(&extras).Error; err != nil {
+		return nil, err
+	}
+	return extras, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(extra *Extra) error {
+	return r.db.Save(extra).Error
+}
+
+// CreateRentExtras implements Repository.
+func (r *repository) CreateRentExtras(items []*RentExtra) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return r.db.Omit("Extra").Create(&items).Error
+}
+
+// FindRentExtras implements Repository.
+// Urut extra_id, sama dengan urutan penguncian di Reserve.
+func (r *repository) FindRentExtras(rentID uint) ([]*RentExtra, error) {
+	var items []*RentExtra
+	if err := r.db.Preload("Extra").Where("rent_id = ?", rentID).Order("extra_id").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ReservedQuantity implements Repository.
+// Jumlah extra yang dipegang rent reserved/ongoing yang periodenya
+// beririsan dengan [start, end). end nil berarti tanpa batas akhir.
+func (r *repository) ReservedQuantity(extraID uint, start time.Time, end *time.Time, excludeRentID uint) (int, error) {
+	query := r.db.Model(&RentExtra{}).
+		Joins("JOIN rents ON rents.id = rent_extras.rent_id").
+		Where("rent_extras.extra_id = ?", extraID).
+		Where("rents.status IN ('reserved', 'ongoing')").
+		Where("rents.planned_end_date IS NULL OR rents.planned_end_date > ?", start)
+	if end != nil {
+		query = query.Where("COALESCE(rents.planned_start_date, rents.rent_date) < ?", *end)
+	}
+	if excludeRentID != 0 {
+		query = query.Where("rents.id <> ?", excludeRentID)
+	}
+
+	var total int
+	err := query.Select("COALESCE(SUM(rent_extras.quantity), 0)").Scan(&total).Error
+	return total, err
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
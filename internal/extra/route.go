@@ -0,0 +1,19 @@
+package extra
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupExtraRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	extra := r.Group("/api/extra")
+	{
+		extra.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateExtra)
+		extra.GET("/", middlewares.Authenticate(cfg), ctrl.GetExtras)
+		extra.GET("/available", middlewares.Authenticate(cfg), ctrl.GetAvailableExtras)
+		extra.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetExtraByID)
+		extra.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateExtra)
+	}
+}
@@ -0,0 +1,214 @@
+package extra
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/pricing"
+	"go-rental/internal/vehicle"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreateExtra(req *ExtraRequest) (*Extra, error)
+	GetAllExtras(filter *ExtraFilter) ([]*Extra, error)
+	GetExtraByID(id uint) (*Extra, error)
+	UpdateExtra(id uint, req *UpdateExtraRequest) (*Extra, error)
+	GetAvailability(filter *AvailabilityFilter) ([]*AvailabilityResponse, error)
+
+	// Dipanggil dari transaksi rent
+	Reserve(tx *gorm.DB, rentID uint, items []ItemRequest, vehicleType vehicle.VehicleType, start time.Time, end *time.Time) error
+	CheckPeriod(tx *gorm.DB, rentID uint, start time.Time, end *time.Time) error
+	Charges(tx *gorm.DB, rentID uint, start, end time.Time) ([]pricing.LineItem, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+// CreateExtra implements Service.
+func (s *service) CreateExtra(req *ExtraRequest) (*Extra, error) {
+	extra := &Extra{
+		Name:        req.Name,
+		Description: req.Description,
+		PriceType:   PriceType(req.PriceType),
+		Price:       req.Price,
+		Stock:       req.Stock,
+		Active:      true,
+	}
+	if req.VehicleType != nil {
+		vt := vehicle.VehicleType(*req.VehicleType)
+		extra.VehicleType = &vt
+	}
+	if err := s.repo.Create(extra); err != nil {
+		return nil, fmt.Errorf("failed to create extra: %w", err)
+	}
+	return extra, nil
+}
+
+// GetAllExtras implements Service.
+func (s *service) GetAllExtras(filter *ExtraFilter) ([]*Extra, error) {
+	extras, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve extras: %w", err)
+	}
+	return extras, nil
+}
+
+// GetExtraByID implements Service.
+func (s *service) GetExtraByID(id uint) (*Extra, error) {
+	extra, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("extra not found: %w", err)
+	}
+	return extra, nil
+}
+
+// UpdateExtra implements Service.
+func (s *service) UpdateExtra(id uint, req *UpdateExtraRequest) (*Extra, error) {
+	extra, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("extra not found: %w", err)
+	}
+
+	// Update only fields that are not nil
+	if req.Name != nil {
+		extra.Name = *req.Name
+	}
+	if req.Description != nil {
+		extra.Description = *req.Description
+	}
+	if req.PriceType != nil {
+		extra.PriceType = PriceType(*req.PriceType)
+	}
+	if req.Price != nil {
+		extra.Price = *req.Price
+	}
+	if req.Stock != nil {
+		extra.Stock = *req.Stock
+	}
+	if req.Active != nil {
+		extra.Active = *req.Active
+	}
+
+	if err := s.repo.Update(extra); err != nil {
+		return nil, fmt.Errorf("failed to update extra: %w", err)
+	}
+	return extra, nil
+}
+
+// GetAvailability implements Service.
+func (s *service) GetAvailability(filter *AvailabilityFilter) ([]*AvailabilityResponse, error) {
+	if !filter.To.After(filter.From) {
+		return nil, errors.New("to must be after from")
+	}
+	extras, err := s.repo.FindAll(&filter.ExtraFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve extras: %w", err)
+	}
+
+	responses := []*AvailabilityResponse{}
+	for _, extra := range extras {
+		reserved, err := s.repo.ReservedQuantity(extra.ID, filter.From, &filter.To, 0)
+		if err != nil {
+			return nil, err
+		}
+		available := extra.Stock - reserved
+		if available < 0 {
+			available = 0
+		}
+		responses = append(responses, &AvailabilityResponse{
+			Extra:     extra,
+			Reserved:  reserved,
+			Available: available,
+		})
+	}
+	return responses, nil
+}
+
+// Reserve implements Service.
+// Baris extra dikunci lalu stok dicek terhadap rent lain yang beririsan,
+// sehingga dua booking paralel tidak bisa memesan stok yang sama.
+func (s *service) Reserve(tx *gorm.DB, rentID uint, items []ItemRequest, vehicleType vehicle.VehicleType, start time.Time, end *time.Time) error {
+	repo := s.repo.WithTx(tx)
+
+	var rentExtras []*RentExtra
+	for _, item := range mergeItems(items) {
+		extra, err := repo.FindByIDForUpdate(item.ExtraID)
+		if err != nil {
+			return fmt.Errorf("extra %d not found", item.ExtraID)
+		}
+		if !extra.Active {
+			return fmt.Errorf("extra %s is not active", extra.Name)
+		}
+		if extra.VehicleType != nil && *extra.VehicleType != vehicleType {
+			return fmt.Errorf("extra %s is only available for %s", extra.Name, *extra.VehicleType)
+		}
+
+		reserved, err := repo.ReservedQuantity(extra.ID, start, end, rentID)
+		if err != nil {
+			return err
+		}
+		if reserved+item.Quantity > extra.Stock {
+			return fmt.Errorf("extra %s: only %d left for the requested period", extra.Name, max(extra.Stock-reserved, 0))
+		}
+
+		rentExtras = append(rentExtras, &RentExtra{
+			RentID:    rentID,
+			ExtraID:   extra.ID,
+			Quantity:  item.Quantity,
+			PriceType: extra.PriceType,
+			UnitPrice: extra.Price,
+		})
+	}
+
+	if err := repo.CreateRentExtras(rentExtras); err != nil {
+		return errors.New("failed to reserve extras")
+	}
+	return nil
+}
+
+// CheckPeriod implements Service.
+// Memastikan extra milik rent masih cukup stoknya untuk periode baru (mis. perpanjangan).
+func (s *service) CheckPeriod(tx *gorm.DB, rentID uint, start time.Time, end *time.Time) error {
+	repo := s.repo.WithTx(tx)
+
+	items, err := repo.FindRentExtras(rentID)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		extra, err := repo.FindByIDForUpdate(item.ExtraID)
+		if err != nil {
+			return fmt.Errorf("extra %d not found", item.ExtraID)
+		}
+		reserved, err := repo.ReservedQuantity(extra.ID, start, end, rentID)
+		if err != nil {
+			return err
+		}
+		if reserved+item.Quantity > extra.Stock {
+			return fmt.Errorf("extra %s is not available for the requested period", extra.Name)
+		}
+	}
+	return nil
+}
+
+// Charges implements Service.
+func (s *service) Charges(tx *gorm.DB, rentID uint, start, end time.Time) ([]pricing.LineItem, error) {
+	items, err := s.repo.WithTx(tx).FindRentExtras(rentID)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]pricing.LineItem, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, toLineItem(item, start, end))
+	}
+	return lines, nil
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo: repo,
+	}
+}
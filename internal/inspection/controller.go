@@ -0,0 +1,131 @@
+package inspection
+
+import (
+	"go-rental/pkg/response"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreateInspection godoc
+// @Summary Record inspection
+// @Description Record a checkout (pickup) or checkin (return) inspection with optional photos
+// @Tags Inspection
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param type formData string true "checkout or checkin"
+// @Param vehicle_id formData int false "Vehicle ID, defaults to the current rent vehicle (use the old vehicle for a checkin after a swap)"
+// @Param odometer formData int true "Odometer reading (km)"
+// @Param fuel_level formData int true "Fuel or battery level (0-100)"
+// @Param damages formData string false "Visible damages"
+// @Param notes formData string false "Notes"
+// @Param photos formData file false "Photos (jpg/png), may be repeated"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/inspections [post]
+func (ctrl *Controller) CreateInspection(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req InspectionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	// Foto opsional, form tanpa file tetap valid
+	var photos []*multipart.FileHeader
+	if form, err := c.MultipartForm(); err == nil {
+		photos = form.File["photos"]
+	}
+
+	inspection, err := ctrl.service.CreateInspection(uint(rentID), &req, photos, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "inspection recorded successfully", inspection)
+}
+
+// GetRentInspections godoc
+// @Summary Get rent inspections
+// @Description Retrieve checkout and checkin inspections of a rent with their comparison, one entry per vehicle used in the rent
+// @Tags Inspection
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/rent/{id}/inspections [get]
+func (ctrl *Controller) GetRentInspections(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	cmp, err := ctrl.service.GetRentInspections(uint(rentID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "inspections retrieved successfully", cmp)
+}
+
+// GetInspectionPhoto godoc
+// @Summary Get inspection photo
+// @Description Download a checkout or checkin inspection photo of a rent
+// @Tags Inspection
+// @Produce image/jpeg
+// @Produce image/png
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param photo_id path int true "Inspection photo ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/rent/{id}/inspections/photos/{photo_id} [get]
+func (ctrl *Controller) GetInspectionPhoto(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+	photoID, err := strconv.ParseUint(c.Param("photo_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid photo ID")
+		return
+	}
+
+	body, err := ctrl.service.GetPhoto(uint(rentID), uint(photoID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	c.Data(http.StatusOK, http.DetectContentType(body), body)
+}
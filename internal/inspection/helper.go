@@ -0,0 +1,61 @@
+package inspection
+
+import "time"
+
+// Compare menyusun perbandingan checkout vs checkin satu kendaraan dalam rent.
+// Nil jika kendaraan tersebut belum punya inspeksi.
+func Compare(inspections []Inspection, vehicleID uint) *Comparison {
+	var cmp *Comparison
+	for i := range inspections {
+		if inspections[i].VehicleID != vehicleID {
+			continue
+		}
+		if cmp == nil {
+			cmp = &Comparison{VehicleID: vehicleID}
+		}
+		switch inspections[i].Type {
+		case TypeCheckout:
+			cmp.Checkout = &inspections[i]
+		case TypeCheckin:
+			cmp.Checkin = &inspections[i]
+		}
+	}
+
+	if cmp != nil && cmp.Checkout != nil && cmp.Checkin != nil {
+		distance := cmp.Checkin.Odometer - cmp.Checkout.Odometer
+		fuel := cmp.Checkin.FuelLevel - cmp.Checkout.FuelLevel
+		cmp.DistanceKm = &distance
+		cmp.FuelDifference = &fuel
+	}
+	return cmp
+}
+
+// CompareAll menyusun perbandingan untuk setiap kendaraan rent, urut sesuai
+// kendaraan pertama kali diinspeksi
+func CompareAll(inspections []Inspection) []Comparison {
+	comparisons := []Comparison{}
+	seen := map[uint]bool{}
+	for _, inspection := range inspections {
+		if seen[inspection.VehicleID] {
+			continue
+		}
+		seen[inspection.VehicleID] = true
+		comparisons = append(comparisons, *Compare(inspections, inspection.VehicleID))
+	}
+	return comparisons
+}
+
+// toSwapInspection membuat inspeksi tanpa foto yang dicatat saat tukar kendaraan
+func toSwapInspection(rentID, vehicleID uint, inspectionType InspectionType, req *SwapInspection, inspectedBy uint, at time.Time) *Inspection {
+	return &Inspection{
+		RentID:        rentID,
+		VehicleID:     vehicleID,
+		Type:          inspectionType,
+		Odometer:      *req.Odometer,
+		FuelLevel:     *req.FuelLevel,
+		Damages:       req.Damages,
+		Notes:         req.Notes,
+		InspectedByID: inspectedBy,
+		InspectedAt:   at,
+	}
+}
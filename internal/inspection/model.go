@@ -0,0 +1,86 @@
+package inspection
+
+import (
+	"go-rental/internal/user"
+	"time"
+)
+
+type InspectionType string
+
+const (
+	TypeCheckout InspectionType = "checkout" // Saat kendaraan diserahkan ke customer
+	TypeCheckin  InspectionType = "checkin"  // Saat kendaraan dikembalikan
+)
+
+// Inspection mencatat kondisi kendaraan saat pickup dan saat return.
+// Satu rent maksimal punya satu checkout dan satu checkin per kendaraan,
+// rent yang pernah tukar kendaraan punya pasangan inspeksi untuk setiap kendaraan.
+type Inspection struct {
+	ID            uint           `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID        uint           `json:"rent_id" gorm:"uniqueIndex:idx_inspection_rent_vehicle_type"`
+	VehicleID     uint           `json:"vehicle_id" gorm:"index;uniqueIndex:idx_inspection_rent_vehicle_type"`
+	Type          InspectionType `json:"type" gorm:"type:enum('checkout', 'checkin');uniqueIndex:idx_inspection_rent_vehicle_type"`
+	Odometer      int            `json:"odometer"`   // km
+	FuelLevel     int            `json:"fuel_level"` // Persen bensin / baterai (0-100)
+	Damages       string         `json:"damages"`    // Kerusakan yang terlihat saat inspeksi
+	Notes         string         `json:"notes"`
+	InspectedByID uint           `json:"inspected_by_id"`
+	InspectedAt   time.Time      `json:"inspected_at"`
+
+	// Relations
+	Photos      []InspectionPhoto `json:"photos" gorm:"foreignKey:InspectionID"`
+	InspectedBy user.User         `json:"inspected_by" gorm:"foreignKey:InspectedByID"`
+}
+
+type InspectionPhoto struct {
+	ID           uint   `json:"id" gorm:"primaryKey;autoIncrement"`
+	InspectionID uint   `json:"inspection_id" gorm:"index"`
+	Path         string `json:"path"` // Path relatif terhadap UploadDir, diunduh lewat GET /api/rent/:id/inspections/photos/:photo_id
+}
+
+// segmentInfo adalah kendaraan yang pernah dipakai dalam rent (tabel rent_segments)
+type segmentInfo struct {
+	RentID    uint
+	VehicleID uint
+}
+
+func (segmentInfo) TableName() string {
+	return "rent_segments"
+}
+
+// rentInfo adalah data minimal rent yang dibutuhkan inspeksi
+type rentInfo struct {
+	ID        uint
+	VehicleID *uint // nil = reservasi class belum di-assign
+	Status    string
+}
+
+func (rentInfo) TableName() string {
+	return "rents"
+}
+
+type InspectionRequest struct {
+	Type      string `form:"type" binding:"required,oneof=checkout checkin"`
+	VehicleID *uint  `form:"vehicle_id"` // default: kendaraan rent saat ini
+	Odometer  *int   `form:"odometer" binding:"required,min=0"`
+	FuelLevel *int   `form:"fuel_level" binding:"required,min=0,max=100"`
+	Damages   string `form:"damages"`
+	Notes     string `form:"notes"`
+}
+
+// SwapInspection adalah kondisi satu kendaraan yang dicatat saat tukar kendaraan
+type SwapInspection struct {
+	Odometer  *int   `json:"odometer" binding:"required,min=0"`
+	FuelLevel *int   `json:"fuel_level" binding:"required,min=0,max=100"`
+	Damages   string `json:"damages"`
+	Notes     string `json:"notes"`
+}
+
+// Comparison membandingkan inspeksi checkout dan checkin satu kendaraan dalam rent
+type Comparison struct {
+	VehicleID      uint        `json:"vehicle_id"`
+	Checkout       *Inspection `json:"checkout"`
+	Checkin        *Inspection `json:"checkin"`
+	DistanceKm     *int        `json:"distance_km"`     // Jarak tempuh selama rent
+	FuelDifference *int        `json:"fuel_difference"` // Negatif berarti bensin/baterai berkurang
+}
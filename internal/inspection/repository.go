@@ -0,0 +1,87 @@
+package inspection
+
+import "gorm.io/gorm"
+
+type Repository interface {
+	Create(inspection *Inspection) error
+	FindByRentID(rentID uint) ([]Inspection, error)
+	FindPhoto(rentID, photoID uint) (*InspectionPhoto, error)
+	FindRent(rentID uint) (*rentInfo, error)
+	FindSegmentVehicleIDs(rentID uint) ([]uint, error)
+	UpdateVehicleOdometer(vehicleID uint, odometer int) error
+	Transaction(fn func(repo Repository) error) error
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(inspection *Inspection) error {
+	return r.db.Create(inspection).Error
+}
+
+// FindByRentID implements Repository.
+func (r *repository) FindByRentID(rentID uint) ([]Inspection, error) {
+	var inspections []Inspection
+	if err := r.db.Preload("Photos").Preload("InspectedBy").Where("rent_id = ?", rentID).Order("inspected_at, id").Find(&inspections).Error; err != nil {
+		return nil, err
+	}
+	return inspections, nil
+}
+
+// FindPhoto implements Repository.
+// Foto hanya ditemukan jika inspeksinya milik rent tersebut.
+func (r *repository) FindPhoto(rentID, photoID uint) (*InspectionPhoto, error) {
+	var photo InspectionPhoto
+	if err := r.db.Joins("JOIN inspections ON inspections.id = inspection_photos.inspection_id").
+		Where("inspection_photos.id = ? AND inspections.rent_id = ?", photoID, rentID).
+		First(&photo).Error; err != nil {
+		return nil, err
+	}
+	return &photo, nil
+}
+
+// FindRent implements Repository.
+func (r *repository) FindRent(rentID uint) (*rentInfo, error) {
+	var info rentInfo
+	if err := r.db.First(&info, rentID).Error; err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// FindSegmentVehicleIDs implements Repository.
+// Kendaraan yang pernah dipakai rent, termasuk yang sudah ditukar.
+func (r *repository) FindSegmentVehicleIDs(rentID uint) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Model(&segmentInfo{}).Where("rent_id = ?", rentID).Distinct().Pluck("vehicle_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// UpdateVehicleOdometer implements Repository.
+// Odometer kendaraan hanya boleh bertambah.
+func (r *repository) UpdateVehicleOdometer(vehicleID uint, odometer int) error {
+	return r.db.Table("vehicles").
+		Where("id = ? AND odometer < ?", vehicleID, odometer).
+		Update("odometer", odometer).Error
+}
+
+// Transaction implements Repository.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{db: tx})
+	})
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,17 @@
+package inspection
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupInspectionRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	inspection := r.Group("/api/rent")
+	{
+		inspection.POST("/:id/inspections", middlewares.Authenticate(cfg), ctrl.CreateInspection)
+		inspection.GET("/:id/inspections", middlewares.Authenticate(cfg), ctrl.GetRentInspections)
+		inspection.GET("/:id/inspections/photos/:photo_id", middlewares.Authenticate(cfg), ctrl.GetInspectionPhoto)
+	}
+}
@@ -0,0 +1,222 @@
+package inspection
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/pkg/config"
+	"go-rental/pkg/upload"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreateInspection(rentID uint, req *InspectionRequest, photos []*multipart.FileHeader, inspectedBy uint) (*Inspection, error)
+	RecordSwap(tx *gorm.DB, rentID, fromVehicleID, toVehicleID uint, checkin, checkout *SwapInspection, inspectedBy uint) error
+	GetRentInspections(rentID uint) ([]Comparison, error)
+	GetPhoto(rentID, photoID uint) ([]byte, error)
+}
+
+type service struct {
+	repo Repository
+	cfg  *config.Config
+}
+
+// CreateInspection implements Service.
+// Tanpa vehicle_id inspeksi dicatat untuk kendaraan rent saat ini. Checkin
+// kendaraan yang sudah ditukar memakai vehicle_id kendaraan lama.
+func (s *service) CreateInspection(rentID uint, req *InspectionRequest, photos []*multipart.FileHeader, inspectedBy uint) (*Inspection, error) {
+	rent, err := s.repo.FindRent(rentID)
+	if err != nil {
+		return nil, errors.New("rent not found")
+	}
+	if rent.VehicleID == nil {
+		return nil, errors.New("rent has no vehicle assigned yet, inspect after pickup")
+	}
+
+	vehicleID := *rent.VehicleID
+	if req.VehicleID != nil && *req.VehicleID != vehicleID {
+		used, err := s.repo.FindSegmentVehicleIDs(rentID)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(used, *req.VehicleID) {
+			return nil, fmt.Errorf("vehicle %d is not part of this rent", *req.VehicleID)
+		}
+		vehicleID = *req.VehicleID
+	}
+
+	existing, err := s.repo.FindByRentID(rentID)
+	if err != nil {
+		return nil, err
+	}
+	cmp := Compare(existing, vehicleID)
+
+	// Status rent disebut sebagai string agar package ini tidak import package rent
+	inspectionType := InspectionType(req.Type)
+	switch inspectionType {
+	case TypeCheckout:
+		if rent.Status != "reserved" && rent.Status != "ongoing" {
+			return nil, errors.New("checkout inspection requires a reserved or ongoing rent")
+		}
+		if vehicleID != *rent.VehicleID {
+			return nil, errors.New("checkout inspection is only allowed for the current vehicle")
+		}
+		if cmp != nil && cmp.Checkout != nil {
+			return nil, errors.New("checkout inspection already recorded")
+		}
+	case TypeCheckin:
+		if rent.Status != "ongoing" && rent.Status != "completed" {
+			return nil, errors.New("checkin inspection requires an ongoing or completed rent")
+		}
+		if cmp == nil || cmp.Checkout == nil {
+			return nil, errors.New("checkout inspection must be recorded first")
+		}
+		if cmp.Checkin != nil {
+			return nil, errors.New("checkin inspection already recorded")
+		}
+		if *req.Odometer < cmp.Checkout.Odometer {
+			return nil, errors.New("odometer cannot be lower than at checkout")
+		}
+	}
+
+	for _, photo := range photos {
+		if !upload.IsImage(photo.Filename) {
+			return nil, fmt.Errorf("photo %s must be jpg or png", photo.Filename)
+		}
+	}
+
+	inspection := &Inspection{
+		RentID:        rent.ID,
+		VehicleID:     vehicleID,
+		Type:          inspectionType,
+		Odometer:      *req.Odometer,
+		FuelLevel:     *req.FuelLevel,
+		Damages:       req.Damages,
+		Notes:         req.Notes,
+		InspectedByID: inspectedBy,
+		InspectedAt:   time.Now(),
+	}
+
+	if err := s.savePhotos(inspection, photos); err != nil {
+		return nil, err
+	}
+
+	// Inspeksi dan odometer kendaraan disimpan bersamaan, foto dihapus lagi
+	// jika transaksi gagal
+	err = s.repo.Transaction(func(repo Repository) error {
+		if err := repo.Create(inspection); err != nil {
+			return fmt.Errorf("failed to create inspection: %w", err)
+		}
+		if err := repo.UpdateVehicleOdometer(vehicleID, inspection.Odometer); err != nil {
+			return fmt.Errorf("failed to update vehicle odometer: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.removePhotos(inspection.Photos)
+		return nil, err
+	}
+
+	return inspection, nil
+}
+
+// savePhotos menyimpan foto ke UploadDir/inspections/<rent_id>/. Jika satu
+// foto gagal, foto yang sudah tersimpan dihapus lagi.
+func (s *service) savePhotos(inspection *Inspection, photos []*multipart.FileHeader) error {
+	for i, photo := range photos {
+		name := fmt.Sprintf("%s-%d-%d-%d%s", inspection.Type, inspection.VehicleID, time.Now().Unix(), i+1, strings.ToLower(filepath.Ext(photo.Filename)))
+		relPath := filepath.ToSlash(filepath.Join("inspections", fmt.Sprint(inspection.RentID), name))
+		if err := upload.Save(photo, filepath.Join(s.cfg.UploadDir, relPath)); err != nil {
+			s.removePhotos(inspection.Photos)
+			inspection.Photos = nil
+			return fmt.Errorf("failed to save photo: %w", err)
+		}
+		inspection.Photos = append(inspection.Photos, InspectionPhoto{Path: relPath})
+	}
+	return nil
+}
+
+// removePhotos menghapus file foto yang tidak jadi dipakai
+func (s *service) removePhotos(photos []InspectionPhoto) {
+	for _, photo := range photos {
+		os.Remove(filepath.Join(s.cfg.UploadDir, filepath.FromSlash(photo.Path)))
+	}
+}
+
+// RecordSwap implements Service.
+// Dipanggil di dalam transaksi tukar kendaraan: checkin kendaraan lama dan
+// checkout kendaraan pengganti dicatat bersamaan, jadi setiap kendaraan
+// punya pasangan inspeksi sendiri.
+func (s *service) RecordSwap(tx *gorm.DB, rentID, fromVehicleID, toVehicleID uint, checkin, checkout *SwapInspection, inspectedBy uint) error {
+	repo := s.repo.WithTx(tx)
+	existing, err := repo.FindByRentID(rentID)
+	if err != nil {
+		return err
+	}
+
+	// Rent lama bisa belum punya checkout, odometer hanya dibandingkan jika ada
+	if old := Compare(existing, fromVehicleID); old != nil {
+		if old.Checkin != nil {
+			return errors.New("checkin inspection already recorded for the current vehicle")
+		}
+		if old.Checkout != nil && *checkin.Odometer < old.Checkout.Odometer {
+			return errors.New("checkin odometer cannot be lower than at checkout")
+		}
+	}
+	if Compare(existing, toVehicleID) != nil {
+		return errors.New("new vehicle already has inspections in this rent")
+	}
+
+	now := time.Now()
+	for _, inspection := range []*Inspection{
+		toSwapInspection(rentID, fromVehicleID, TypeCheckin, checkin, inspectedBy, now),
+		toSwapInspection(rentID, toVehicleID, TypeCheckout, checkout, inspectedBy, now),
+	} {
+		if err := repo.Create(inspection); err != nil {
+			return fmt.Errorf("failed to create %s inspection: %w", inspection.Type, err)
+		}
+		if err := repo.UpdateVehicleOdometer(inspection.VehicleID, inspection.Odometer); err != nil {
+			return fmt.Errorf("failed to update vehicle odometer: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetRentInspections implements Service.
+func (s *service) GetRentInspections(rentID uint) ([]Comparison, error) {
+	if _, err := s.repo.FindRent(rentID); err != nil {
+		return nil, errors.New("rent not found")
+	}
+	inspections, err := s.repo.FindByRentID(rentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve inspections: %w", err)
+	}
+	return CompareAll(inspections), nil
+}
+
+// GetPhoto implements Service.
+// Foto inspeksi adalah bukti klaim, jadi tidak disajikan sebagai static file.
+func (s *service) GetPhoto(rentID, photoID uint) ([]byte, error) {
+	photo, err := s.repo.FindPhoto(rentID, photoID)
+	if err != nil {
+		return nil, errors.New("photo not found")
+	}
+	body, err := os.ReadFile(filepath.Join(s.cfg.UploadDir, filepath.FromSlash(photo.Path)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	return body, nil
+}
+
+func NewService(repo Repository, cfg *config.Config) Service {
+	return &service{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
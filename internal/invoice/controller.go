@@ -0,0 +1,61 @@
+package invoice
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// GetRentInvoice godoc
+// @Summary Get rent invoice
+// @Description Download the invoice of a completed rent as PDF (default), HTML or JSON
+// @Tags Invoice
+// @Produce application/pdf
+// @Produce text/html
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param format query string false "pdf, html or json"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/rent/{id}/invoice [get]
+func (ctrl *Controller) GetRentInvoice(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	format := Format(c.DefaultQuery("format", string(FormatPDF)))
+	if format == FormatJSON {
+		doc, err := ctrl.service.GetRentInvoice(uint(rentID))
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Success(c, http.StatusOK, "invoice retrieved successfully", doc)
+		return
+	}
+
+	body, contentType, err := ctrl.service.RenderRentInvoice(uint(rentID), format)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if format != FormatHTML {
+		c.Header("Content-Disposition", "attachment; filename=invoice-"+c.Param("id")+".pdf")
+	}
+	c.Data(http.StatusOK, contentType, body)
+}
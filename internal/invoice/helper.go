@@ -0,0 +1,143 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"go-rental/pkg/clock"
+	"go-rental/pkg/pdf"
+	"html/template"
+	"math"
+	"time"
+)
+
+const dateLayout = "2006-01-02 15:04"
+
+var htmlTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"money": func(v float64) string { return fmt.Sprintf("%.2f", v) },
+	"date":  formatDate,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Invoice {{.Invoice.Number}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; font-size: 13px; margin: 40px; }
+table { width: 100%; border-collapse: collapse; margin-top: 16px; }
+th, td { padding: 6px; border-bottom: 1px solid #ddd; text-align: left; }
+td.num, th.num { text-align: right; }
+</style>
+</head>
+<body>
+<h2>{{.Invoice.CompanyName}}</h2>
+<div>{{.Invoice.CompanyAddress}}</div>
+<div>{{.Invoice.CompanyPhone}} {{.Invoice.CompanyEmail}}</div>
+{{if .Invoice.CompanyTaxID}}<div>NPWP: {{.Invoice.CompanyTaxID}}</div>{{end}}
+
+<h3>INVOICE {{.Invoice.Number}}</h3>
+<div>Issued: {{date .Invoice.IssuedAt}}</div>
+<div>Customer: {{.Invoice.CustomerName}}, {{.Invoice.CustomerPhone}}</div>
+<div>{{.Invoice.CustomerAddress}}</div>
+<div>Vehicle: {{.Invoice.VehicleName}} - {{.Invoice.PlateNumber}}</div>
+<div>Period: {{date .Invoice.RentDate}}{{if .Invoice.ReturnDate}} - {{date .Invoice.ReturnDate}}{{end}}</div>
+
+<table>
+<tr><th>Description</th><th class="num">Qty</th><th class="num">Unit price</th><th class="num">Amount</th></tr>
+{{range .Invoice.Items}}<tr><td>{{.Description}}</td><td class="num">{{.Quantity}}</td><td class="num">{{money .UnitPrice}}</td><td class="num">{{money .Amount}}</td></tr>
+{{end}}
+<tr><td colspan="3" class="num">Subtotal</td><td class="num">{{money .Invoice.Subtotal}}</td></tr>
+<tr><td colspan="3" class="num">Tax {{.Invoice.TaxPercent}}% (included)</td><td class="num">{{money .Invoice.TaxAmount}}</td></tr>
+<tr><td colspan="3" class="num"><b>Total</b></td><td class="num"><b>{{money .Invoice.Total}}</b></td></tr>
+<tr><td colspan="3" class="num">Paid</td><td class="num">{{money .Paid}}</td></tr>
+<tr><td colspan="3" class="num"><b>Balance due</b></td><td class="num"><b>{{money .BalanceDue}}</b></td></tr>
+</table>
+</body>
+</html>
+`))
+
+// formatDate menampilkan tanggal invoice dalam zona waktu bisnis
+func formatDate(t time.Time) string {
+	return t.In(clock.Location()).Format(dateLayout + " MST")
+}
+
+func renderHTML(doc *InvoiceDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, doc); err != nil {
+		return nil, fmt.Errorf("failed to render invoice: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderPDF(doc *InvoiceDocument) []byte {
+	inv := doc.Invoice
+	p := pdf.New()
+
+	y := 60.0
+	line := func(x float64, size float64, bold bool, text string) {
+		p.Text(x, y, size, bold, text)
+		y += size + 6
+	}
+
+	line(50, 16, true, inv.CompanyName)
+	line(50, 10, false, inv.CompanyAddress)
+	line(50, 10, false, inv.CompanyPhone+" "+inv.CompanyEmail)
+	if inv.CompanyTaxID != "" {
+		line(50, 10, false, "NPWP: "+inv.CompanyTaxID)
+	}
+
+	y += 10
+	line(50, 14, true, "INVOICE "+inv.Number)
+	line(50, 10, false, "Issued: "+formatDate(inv.IssuedAt))
+	line(50, 10, false, "Customer: "+inv.CustomerName+", "+inv.CustomerPhone)
+	line(50, 10, false, inv.CustomerAddress)
+	line(50, 10, false, "Vehicle: "+inv.VehicleName+" - "+inv.PlateNumber)
+	period := formatDate(inv.RentDate)
+	if inv.ReturnDate != nil {
+		period += " - " + formatDate(*inv.ReturnDate)
+	}
+	line(50, 10, false, "Period: "+period)
+
+	// Tabel item
+	y += 10
+	p.Text(50, y, 10, true, "Description")
+	p.Text(340, y, 10, true, "Qty")
+	p.Text(400, y, 10, true, "Unit price")
+	p.Text(490, y, 10, true, "Amount")
+	p.Line(50, y+5, 545, y+5)
+	y += 20
+	for _, item := range inv.Items {
+		if y > pdf.PageHeight-120 {
+			p.AddPage()
+			y = 60
+		}
+		p.Text(50, y, 10, false, item.Description)
+		p.Text(340, y, 10, false, fmt.Sprintf("%g", item.Quantity))
+		p.Text(400, y, 10, false, fmt.Sprintf("%.2f", item.UnitPrice))
+		p.Text(490, y, 10, false, fmt.Sprintf("%.2f", item.Amount))
+		y += 16
+	}
+	p.Line(50, y-8, 545, y-8)
+
+	y += 6
+	totals := []struct {
+		label string
+		value float64
+		bold  bool
+	}{
+		{"Subtotal", inv.Subtotal, false},
+		{fmt.Sprintf("Tax %g%% (included)", inv.TaxPercent), inv.TaxAmount, false},
+		{"Total", inv.Total, true},
+		{"Paid", doc.Paid, false},
+		{"Balance due", doc.BalanceDue, true},
+	}
+	for _, t := range totals {
+		p.Text(340, y, 10, t.bold, t.label)
+		p.Text(490, y, 10, t.bold, fmt.Sprintf("%.2f", t.value))
+		y += 16
+	}
+
+	return p.Bytes()
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
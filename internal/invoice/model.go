@@ -0,0 +1,71 @@
+package invoice
+
+import (
+	"encoding/json"
+	"go-rental/pkg/clock"
+	"time"
+)
+
+// Invoice adalah snapshot tagihan rent yang sudah completed. Data customer,
+// kendaraan dan perusahaan disalin agar invoice tidak berubah di kemudian hari.
+type Invoice struct {
+	ID       uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID   uint      `json:"rent_id" gorm:"uniqueIndex"`
+	Number   string    `json:"number" gorm:"type:varchar(30);uniqueIndex"`
+	Year     int       `json:"year" gorm:"uniqueIndex:idx_invoice_year_sequence"`
+	Sequence int       `json:"sequence" gorm:"uniqueIndex:idx_invoice_year_sequence"`
+	IssuedAt time.Time `json:"issued_at"`
+
+	CompanyName    string `json:"company_name"`
+	CompanyAddress string `json:"company_address"`
+	CompanyPhone   string `json:"company_phone"`
+	CompanyEmail   string `json:"company_email"`
+	CompanyTaxID   string `json:"company_tax_id"`
+
+	CustomerName    string `json:"customer_name"`
+	CustomerAddress string `json:"customer_address"`
+	CustomerPhone   string `json:"customer_phone"`
+	VehicleName     string `json:"vehicle_name"`
+	PlateNumber     string `json:"plate_number"`
+
+	RentDate   time.Time  `json:"rent_date"`
+	ReturnDate *time.Time `json:"return_date"`
+
+	Subtotal   float64 `json:"subtotal"` // Total sebelum pajak
+	TaxPercent float64 `json:"tax_percent"`
+	TaxAmount  float64 `json:"tax_amount"`
+	Total      float64 `json:"total"` // Sama dengan TotalPrice rent
+
+	Items []InvoiceItem `json:"items" gorm:"foreignKey:InvoiceID"`
+}
+
+// MarshalJSON menampilkan waktu Invoice dalam zona bisnis
+func (i Invoice) MarshalJSON() ([]byte, error) {
+	type alias Invoice
+	i.IssuedAt = clock.In(i.IssuedAt)
+	i.RentDate = clock.In(i.RentDate)
+	i.ReturnDate = clock.InPtr(i.ReturnDate)
+	return json.Marshal(alias(i))
+}
+
+type InvoiceItem struct {
+	ID          uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	InvoiceID   uint    `json:"invoice_id" gorm:"index"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+	Amount      float64 `json:"amount"`
+}
+
+// InvoiceSequence menyimpan nomor terakhir per tahun untuk penomoran berurutan
+type InvoiceSequence struct {
+	Year       int `gorm:"primaryKey;autoIncrement:false"`
+	LastNumber int
+}
+
+// InvoiceDocument = invoice + status pembayaran saat dokumen dibuat (receipt)
+type InvoiceDocument struct {
+	Invoice    *Invoice `json:"invoice"`
+	Paid       float64  `json:"paid"`
+	BalanceDue float64  `json:"balance_due"`
+}
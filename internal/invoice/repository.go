@@ -0,0 +1,54 @@
+package invoice
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	CreateWithNextNumber(invoice *Invoice) error
+	FindByRentID(rentID uint) (*Invoice, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// CreateWithNextNumber implements Repository.
+// Nomor diambil dari invoice_sequences yang dikunci di transaksi yang sama
+// dengan insert invoice, sehingga nomor per tahun selalu berurutan tanpa lompat.
+func (r *repository) CreateWithNextNumber(invoice *Invoice) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		seq := InvoiceSequence{Year: invoice.Year}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&seq).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&seq, "year = ?", invoice.Year).Error; err != nil {
+			return err
+		}
+
+		seq.LastNumber++
+		if err := tx.Save(&seq).Error; err != nil {
+			return err
+		}
+
+		invoice.Sequence = seq.LastNumber
+		invoice.Number = fmt.Sprintf("INV/%d/%06d", invoice.Year, seq.LastNumber)
+		return tx.Create(invoice).Error
+	})
+}
+
+// FindByRentID implements Repository.
+func (r *repository) FindByRentID(rentID uint) (*Invoice, error) {
+	var invoice Invoice
+	if err := r.db.Preload("Items").Where("rent_id = ?", rentID).First(&invoice).Error; err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
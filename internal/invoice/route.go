@@ -0,0 +1,15 @@
+package invoice
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupInvoiceRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	invoice := r.Group("/api/rent")
+	{
+		invoice.GET("/:id/invoice", middlewares.Authenticate(cfg), ctrl.GetRentInvoice)
+	}
+}
@@ -0,0 +1,163 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/rent"
+	"go-rental/pkg/clock"
+	"go-rental/pkg/config"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Format string
+
+const (
+	FormatPDF  Format = "pdf"
+	FormatHTML Format = "html"
+	FormatJSON Format = "json"
+)
+
+type Service interface {
+	IssueInvoice(rentID uint) error
+	GetRentInvoice(rentID uint) (*InvoiceDocument, error)
+	RenderRentInvoice(rentID uint, format Format) ([]byte, string, error)
+}
+
+type service struct {
+	repo     Repository
+	rentRepo rent.Repository
+	payments rent.PaymentLedger
+	cfg      *config.Config
+}
+
+// IssueInvoice implements Service.
+// Idempotent: jika rent sudah punya invoice, tidak dibuat nomor baru.
+func (s *service) IssueInvoice(rentID uint) error {
+	_, err := s.getOrIssue(rentID)
+	return err
+}
+
+// GetRentInvoice implements Service.
+func (s *service) GetRentInvoice(rentID uint) (*InvoiceDocument, error) {
+	inv, err := s.getOrIssue(rentID)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := s.payments.GetReceivedAmount(rentID)
+	if err != nil {
+		return nil, err
+	}
+	return &InvoiceDocument{
+		Invoice:    inv,
+		Paid:       received,
+		BalanceDue: round2(inv.Total - received),
+	}, nil
+}
+
+// RenderRentInvoice implements Service.
+// Mengembalikan isi dokumen beserta content type-nya.
+func (s *service) RenderRentInvoice(rentID uint, format Format) ([]byte, string, error) {
+	doc, err := s.GetRentInvoice(rentID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch format {
+	case FormatHTML:
+		body, err := renderHTML(doc)
+		return body, "text/html; charset=utf-8", err
+	case FormatPDF, "":
+		return renderPDF(doc), "application/pdf", nil
+	default:
+		return nil, "", errors.New("invalid format (use pdf or html)")
+	}
+}
+
+func (s *service) getOrIssue(rentID uint) (*Invoice, error) {
+	inv, err := s.repo.FindByRentID(rentID)
+	if err == nil {
+		return inv, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	rt, err := s.rentRepo.FindByID(rentID)
+	if err != nil {
+		return nil, errors.New("rent not found")
+	}
+	if rt.Status != rent.StatusCompleted && rt.Status != rent.StatusClosed {
+		return nil, errors.New("invoice is only available for completed rent")
+	}
+
+	inv = s.buildInvoice(rt)
+	if err := s.repo.CreateWithNextNumber(inv); err != nil {
+		// Bisa jadi invoice dibuat bersamaan oleh request lain
+		if existing, findErr := s.repo.FindByRentID(rentID); findErr == nil {
+			return existing, nil
+		}
+		return nil, fmt.Errorf("failed to issue invoice: %w", err)
+	}
+	return inv, nil
+}
+
+// buildInvoice menyalin data rent ke invoice. Harga sudah termasuk pajak,
+// jadi pajak dipecah dari total: tax = total * rate / (100 + rate).
+func (s *service) buildInvoice(rt *rent.Rent) *Invoice {
+	// Tahun penomoran invoice mengikuti tanggal di zona bisnis
+	now := time.Now().In(clock.Location())
+	taxPercent := s.taxPercent()
+	taxAmount := round2(rt.TotalPrice * taxPercent / (100 + taxPercent))
+
+	inv := &Invoice{
+		RentID:          rt.ID,
+		Year:            now.Year(),
+		IssuedAt:        now,
+		CompanyName:     s.cfg.CompanyName,
+		CompanyAddress:  s.cfg.CompanyAddress,
+		CompanyPhone:    s.cfg.CompanyPhone,
+		CompanyEmail:    s.cfg.CompanyEmail,
+		CompanyTaxID:    s.cfg.CompanyTaxID,
+		CustomerName:    rt.Customer.Name,
+		CustomerAddress: rt.Customer.Address,
+		CustomerPhone:   rt.Customer.Phone,
+		VehicleName:     fmt.Sprintf("%s %s (%d)", rt.Vehicle.Brand, rt.Vehicle.Model, rt.Vehicle.Year),
+		PlateNumber:     rt.Vehicle.PlateNumber,
+		RentDate:        rt.RentDate,
+		ReturnDate:      rt.ReturnDate,
+		Subtotal:        round2(rt.TotalPrice - taxAmount),
+		TaxPercent:      taxPercent,
+		TaxAmount:       taxAmount,
+		Total:           rt.TotalPrice,
+	}
+	for _, charge := range rt.Charges {
+		inv.Items = append(inv.Items, InvoiceItem{
+			Description: charge.Description,
+			Quantity:    charge.Quantity,
+			UnitPrice:   charge.UnitPrice,
+			Amount:      charge.Amount,
+		})
+	}
+	return inv
+}
+
+func (s *service) taxPercent() float64 {
+	percent, err := strconv.ParseFloat(s.cfg.InvoiceTaxPercent, 64)
+	if err != nil {
+		percent = 11 // default PPN 11%
+	}
+	return percent
+}
+
+func NewService(repo Repository, rentRepo rent.Repository, payments rent.PaymentLedger, cfg *config.Config) Service {
+	return &service{
+		repo:     repo,
+		rentRepo: rentRepo,
+		payments: payments,
+		cfg:      cfg,
+	}
+}
@@ -0,0 +1,302 @@
+package maintenance
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service  Service
+	capacity ClassCapacity
+}
+
+func NewController(s Service, capacity ClassCapacity) *Controller {
+	return &Controller{
+		service:  s,
+		capacity: capacity,
+	}
+}
+
+// CreatePlan godoc
+// @Summary Create maintenance plan
+// @Description Create a preventive maintenance plan for a vehicle with a date and/or odometer interval
+// @Tags Maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body PlanRequest true "Plan data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/plans [post]
+func (ctrl *Controller) CreatePlan(c *gin.Context) {
+	var req PlanRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	plan, err := ctrl.service.CreatePlan(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "maintenance plan created successfully", plan)
+}
+
+// GetPlans godoc
+// @Summary Get maintenance plans
+// @Description Retrieve maintenance plans, optionally filtered by vehicle
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param active query bool false "Active only"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/plans [get]
+func (ctrl *Controller) GetPlans(c *gin.Context) {
+	var filter PlanFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	plans, err := ctrl.service.GetAllPlans(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "maintenance plans retrieved successfully", plans)
+}
+
+// UpdatePlan godoc
+// @Summary Update maintenance plan
+// @Description Update the intervals or status of a maintenance plan
+// @Tags Maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Plan ID"
+// @Param data body UpdatePlanRequest true "Plan update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/plans/{id} [put]
+func (ctrl *Controller) UpdatePlan(c *gin.Context) {
+	planID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid plan ID")
+		return
+	}
+
+	var req UpdatePlanRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	plan, err := ctrl.service.UpdatePlan(uint(planID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "maintenance plan updated successfully", plan)
+}
+
+// GetDue godoc
+// @Summary Get due maintenance
+// @Description List active plans that are overdue or due within the given days / km (default 7 days / 500 km)
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param within_days query int false "Due within N days"
+// @Param within_km query int false "Due within N km"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/due [get]
+func (ctrl *Controller) GetDue(c *gin.Context) {
+	var filter DueFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	due, err := ctrl.service.GetDue(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "due maintenance retrieved successfully", due)
+}
+
+// CreateWorkOrder godoc
+// @Summary Schedule work order
+// @Description Schedule a maintenance window; it must not overlap rents, must leave the vehicle class enough vehicles for its class reservations, and blocks new reservations for that window
+// @Tags Maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body WorkOrderRequest true "Work order data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders [post]
+func (ctrl *Controller) CreateWorkOrder(c *gin.Context) {
+	var req WorkOrderRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	order, err := ctrl.service.CreateWorkOrder(&req, ctrl.capacity, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "work order scheduled successfully", order)
+}
+
+// GetWorkOrders godoc
+// @Summary Get work orders
+// @Description Retrieve work orders filtered by vehicle, status or window period
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param status query string false "scheduled, in_progress, completed or cancelled"
+// @Param from query string false "Window overlaps from (RFC3339)"
+// @Param to query string false "Window overlaps until (RFC3339)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders [get]
+func (ctrl *Controller) GetWorkOrders(c *gin.Context) {
+	var filter WorkOrderFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	orders, err := ctrl.service.GetAllWorkOrders(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "work orders retrieved successfully", orders)
+}
+
+// GetWorkOrderByID godoc
+// @Summary Get work order by ID
+// @Description Retrieve a work order by its ID
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work order ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders/{id} [get]
+func (ctrl *Controller) GetWorkOrderByID(c *gin.Context) {
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid work order ID")
+		return
+	}
+
+	order, err := ctrl.service.GetWorkOrderByID(uint(orderID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "work order retrieved successfully", order)
+}
+
+// StartWorkOrder godoc
+// @Summary Start work order
+// @Description Start a scheduled work order and move the vehicle into maintenance
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work order ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders/{id}/start [post]
+func (ctrl *Controller) StartWorkOrder(c *gin.Context) {
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid work order ID")
+		return
+	}
+
+	order, err := ctrl.service.StartWorkOrder(uint(orderID))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "work order started successfully", order)
+}
+
+// CompleteWorkOrder godoc
+// @Summary Complete work order
+// @Description Finish a work order, record odometer and cost, reset its plan and move the vehicle out of maintenance unless it still has open severe damage reports or other in-progress work orders
+// @Tags Maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work order ID"
+// @Param data body CompleteWorkOrderRequest true "Completion data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders/{id}/complete [post]
+func (ctrl *Controller) CompleteWorkOrder(c *gin.Context) {
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid work order ID")
+		return
+	}
+
+	var req CompleteWorkOrderRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	order, err := ctrl.service.CompleteWorkOrder(uint(orderID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "work order completed successfully", order)
+}
+
+// CancelWorkOrder godoc
+// @Summary Cancel work order
+// @Description Cancel a work order that has not started, releasing its maintenance window
+// @Tags Maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work order ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/maintenance/work-orders/{id}/cancel [post]
+func (ctrl *Controller) CancelWorkOrder(c *gin.Context) {
+	orderID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid work order ID")
+		return
+	}
+
+	order, err := ctrl.service.CancelWorkOrder(uint(orderID))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "work order cancelled successfully", order)
+}
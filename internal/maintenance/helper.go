@@ -0,0 +1,52 @@
+package maintenance
+
+import (
+	"go-rental/pkg/clock"
+	"math"
+	"time"
+)
+
+// evaluatePlan menghitung jatuh tempo plan berikutnya berdasarkan tanggal
+// dan odometer kendaraan saat ini. Status diambil dari yang paling mendesak.
+func evaluatePlan(plan *Plan, now time.Time, withinDays, withinKm int) *DueResponse {
+	resp := &DueResponse{Plan: plan, Status: DueOK}
+
+	if plan.IntervalDays > 0 {
+		next := plan.LastServiceDate.AddDate(0, 0, plan.IntervalDays)
+		daysLeft := int(math.Floor(next.Sub(now).Hours() / 24))
+		resp.NextDueDate = clock.Format(next)
+		resp.DaysLeft = &daysLeft
+		switch {
+		case !now.Before(next):
+			resp.Status = DueOverdue
+		case daysLeft <= withinDays:
+			resp.Status = DueSoon
+		}
+	}
+
+	if plan.IntervalKm > 0 {
+		next := plan.LastServiceOdometer + plan.IntervalKm
+		kmLeft := next - plan.Vehicle.Odometer
+		resp.NextDueOdometer = &next
+		resp.KmLeft = &kmLeft
+		switch {
+		case kmLeft <= 0:
+			resp.Status = DueOverdue
+		case kmLeft <= withinKm && resp.Status == DueOK:
+			resp.Status = DueSoon
+		}
+	}
+
+	return resp
+}
+
+// canTransition: scheduled -> in_progress / cancelled, in_progress -> completed
+func canTransition(from, to OrderStatus) bool {
+	switch from {
+	case OrderScheduled:
+		return to == OrderInProgress || to == OrderCancelled
+	case OrderInProgress:
+		return to == OrderCompleted
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"go-rental/internal/user"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"time"
+)
+
+type OrderStatus string
+type DueStatus string
+
+const (
+	OrderScheduled  OrderStatus = "scheduled"
+	OrderInProgress OrderStatus = "in_progress"
+	OrderCompleted  OrderStatus = "completed"
+	OrderCancelled  OrderStatus = "cancelled"
+)
+
+const (
+	DueOK      DueStatus = "ok"
+	DueSoon    DueStatus = "due"
+	DueOverdue DueStatus = "overdue"
+)
+
+// Plan adalah jadwal servis berkala satu kendaraan. Servis jatuh tempo
+// setiap IntervalDays hari atau IntervalKm km sejak servis terakhir,
+// mana yang lebih dulu. Interval 0 berarti tidak dipakai.
+type Plan struct {
+	ID                  uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleID           uint      `json:"vehicle_id" gorm:"index"`
+	Name                string    `json:"name"` // contoh: Ganti oli, Servis besar
+	IntervalDays        int       `json:"interval_days"`
+	IntervalKm          int       `json:"interval_km"`
+	LastServiceDate     time.Time `json:"last_service_date"`
+	LastServiceOdometer int       `json:"last_service_odometer"`
+	Active              bool      `json:"active" gorm:"default:true"`
+	Notes               string    `json:"notes"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+
+	Vehicle vehicle.Vehicle `json:"vehicle" gorm:"foreignKey:VehicleID"`
+}
+
+func (Plan) TableName() string {
+	return "maintenance_plans"
+}
+
+// WorkOrder adalah pekerjaan servis pada jendela waktu tertentu.
+// Work order scheduled/in_progress memblokir reservasi yang beririsan
+// dengan [ScheduledStart, ScheduledEnd).
+type WorkOrder struct {
+	ID             uint        `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleID      uint        `json:"vehicle_id" gorm:"index"`
+	PlanID         *uint       `json:"plan_id" gorm:"index;default:null"`
+	Title          string      `json:"title"`
+	Description    string      `json:"description"`
+	Status         OrderStatus `json:"status" gorm:"type:enum('scheduled', 'in_progress', 'completed', 'cancelled');default:'scheduled'"`
+	ScheduledStart time.Time   `json:"scheduled_start"`
+	ScheduledEnd   time.Time   `json:"scheduled_end"`
+	StartedAt      *time.Time  `json:"started_at" gorm:"default:null"`
+	CompletedAt    *time.Time  `json:"completed_at" gorm:"default:null"`
+	Odometer       int         `json:"odometer"` // km saat servis selesai
+	Cost           float64     `json:"cost"`
+	Notes          string      `json:"notes"`
+	CreatedByID    uint        `json:"created_by_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+
+	Vehicle   vehicle.Vehicle `json:"vehicle" gorm:"foreignKey:VehicleID"`
+	Plan      *Plan           `json:"plan,omitempty" gorm:"foreignKey:PlanID"`
+	CreatedBy user.User       `json:"created_by" gorm:"foreignKey:CreatedByID"`
+}
+
+// MarshalJSON menampilkan waktu WorkOrder dalam zona bisnis
+func (w WorkOrder) MarshalJSON() ([]byte, error) {
+	type alias WorkOrder
+	w.ScheduledStart = clock.In(w.ScheduledStart)
+	w.ScheduledEnd = clock.In(w.ScheduledEnd)
+	w.CreatedAt = clock.In(w.CreatedAt)
+	w.StartedAt = clock.InPtr(w.StartedAt)
+	w.CompletedAt = clock.InPtr(w.CompletedAt)
+	return json.Marshal(alias(w))
+}
+
+func (WorkOrder) TableName() string {
+	return "maintenance_work_orders"
+}
+
+type PlanRequest struct {
+	VehicleID           uint       `json:"vehicle_id" form:"vehicle_id" binding:"required"`
+	Name                string     `json:"name" form:"name" binding:"required"`
+	IntervalDays        int        `json:"interval_days" form:"interval_days" binding:"min=0"`
+	IntervalKm          int        `json:"interval_km" form:"interval_km" binding:"min=0"`
+	LastServiceDate     *time.Time `json:"last_service_date" form:"last_service_date"`         // default: sekarang
+	LastServiceOdometer *int       `json:"last_service_odometer" form:"last_service_odometer"` // default: odometer kendaraan
+	Notes               string     `json:"notes" form:"notes"`
+}
+
+type UpdatePlanRequest struct {
+	Name         *string `json:"name" form:"name" binding:"omitempty"`
+	IntervalDays *int    `json:"interval_days" form:"interval_days" binding:"omitempty,min=0"`
+	IntervalKm   *int    `json:"interval_km" form:"interval_km" binding:"omitempty,min=0"`
+	Active       *bool   `json:"active" form:"active" binding:"omitempty"`
+	Notes        *string `json:"notes" form:"notes" binding:"omitempty"`
+}
+
+type PlanFilter struct {
+	VehicleID *uint `form:"vehicle_id"`
+	Active    *bool `form:"active"`
+}
+
+// DueFilter: plan dianggap "due" jika jatuh tempo dalam WithinDays hari
+// atau WithinKm km ke depan. Default 7 hari / 500 km.
+type DueFilter struct {
+	VehicleID  *uint `form:"vehicle_id"`
+	WithinDays *int  `form:"within_days" binding:"omitempty,min=0"`
+	WithinKm   *int  `form:"within_km" binding:"omitempty,min=0"`
+}
+
+type DueResponse struct {
+	Plan            *Plan     `json:"plan"`
+	Status          DueStatus `json:"status"`
+	NextDueDate     string    `json:"next_due_date,omitempty"`
+	NextDueOdometer *int      `json:"next_due_odometer,omitempty"`
+	DaysLeft        *int      `json:"days_left,omitempty"` // negatif jika sudah lewat
+	KmLeft          *int      `json:"km_left,omitempty"`   // negatif jika sudah lewat
+}
+
+type WorkOrderRequest struct {
+	VehicleID      uint      `json:"vehicle_id" form:"vehicle_id" binding:"required"`
+	PlanID         *uint     `json:"plan_id" form:"plan_id" binding:"omitempty"`
+	Title          string    `json:"title" form:"title" binding:"required"`
+	Description    string    `json:"description" form:"description"`
+	ScheduledStart time.Time `json:"scheduled_start" form:"scheduled_start" binding:"required"`
+	ScheduledEnd   time.Time `json:"scheduled_end" form:"scheduled_end" binding:"required"`
+}
+
+type CompleteWorkOrderRequest struct {
+	Odometer *int    `json:"odometer" form:"odometer" binding:"required,min=0"`
+	Cost     float64 `json:"cost" form:"cost" binding:"min=0"`
+	Notes    string  `json:"notes" form:"notes"`
+}
+
+type WorkOrderFilter struct {
+	VehicleID *uint      `form:"vehicle_id"`
+	Status    *string    `form:"status" binding:"omitempty,oneof=scheduled in_progress completed cancelled"`
+	From      *time.Time `form:"from"`
+	To        *time.Time `form:"to"`
+}
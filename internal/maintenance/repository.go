@@ -0,0 +1,234 @@
+package maintenance
+
+import (
+	"go-rental/internal/vehicle"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	CreatePlan(plan *Plan) error
+	FindPlanByID(id uint) (*Plan, error)
+	FindPlans(filter *PlanFilter) ([]*Plan, error)
+	UpdatePlan(plan *Plan) error
+
+	CreateWorkOrder(order *WorkOrder) error
+	FindWorkOrderByID(id uint) (*WorkOrder, error)
+	FindWorkOrderByIDForUpdate(id uint) (*WorkOrder, error)
+	FindWorkOrders(filter *WorkOrderFilter) ([]*WorkOrder, error)
+	UpdateWorkOrder(order *WorkOrder) error
+	HasWindowOverlap(vehicleID uint, start time.Time, end *time.Time, excludeID uint) (bool, error)
+	FindWindows(vehicleIDs []uint, start, end time.Time) ([]*WorkOrder, error)
+	CountInProgress(vehicleID uint, excludeID uint) (int64, error)
+	CountOpenSevereDamage(vehicleID uint) (int64, error)
+
+	// Dipakai saat menjadwalkan / memulai work order
+	FindVehicleForUpdate(id uint) (*vehicle.Vehicle, error)
+	UpdateVehicle(vh *vehicle.Vehicle) error
+	HasRentOverlap(vehicleID uint, start, end time.Time) (bool, error)
+
+	Transaction(fn func(repo Repository) error) error
+	// Tx mengembalikan transaksi yang sedang berjalan, supaya service
+	// package lain bisa ikut transaksi yang sama
+	Tx() *gorm.DB
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// CreatePlan implements Repository.
+func (r *repository) CreatePlan(plan *Plan) error {
+	return r.db.Omit("Vehicle").Create(plan).Error
+}
+
+// FindPlanByID implements Repository.
+func (r *repository) FindPlanByID(id uint) (*Plan, error) {
+	var plan Plan
+	if err := r.db.Preload("Vehicle").First(&plan, id).Error; err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// FindPlans implements Repository.
+func (r *repository) FindPlans(filter *PlanFilter) ([]*Plan, error) {
+	var plans []*Plan
+	query := r.db.Preload("Vehicle").Model(&Plan{})
+	if filter.VehicleID != nil {
+		query = query.Where("vehicle_id = ?", *filter.VehicleID)
+	}
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+	if err := query.Order("id asc").Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// UpdatePlan implements Repository.
+func (r *repository) UpdatePlan(plan *Plan) error {
+	return r.db.Omit("Vehicle").Save(plan).Error
+}
+
+// CreateWorkOrder implements Repository.
+func (r *repository) CreateWorkOrder(order *WorkOrder) error {
+	return r.db.Omit("Vehicle", "Plan", "CreatedBy").Create(order).Error
+}
+
+// FindWorkOrderByID implements Repository.
+func (r *repository) FindWorkOrderByID(id uint) (*WorkOrder, error) {
+	var order WorkOrder
+	if err := r.db.Preload("Vehicle").Preload("Plan").Preload("CreatedBy").First(&order, id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindWorkOrderByIDForUpdate implements Repository.
+func (r *repository) FindWorkOrderByIDForUpdate(id uint) (*WorkOrder, error) {
+	var order WorkOrder
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindWorkOrders implements Repository.
+// From/To memfilter work order yang jendelanya beririsan dengan periode tersebut.
+func (r *repository) FindWorkOrders(filter *WorkOrderFilter) ([]*WorkOrder, error) {
+	var orders []*WorkOrder
+	query := r.db.Preload("Vehicle").Preload("Plan").Preload("CreatedBy").Model(&WorkOrder{})
+	if filter.VehicleID != nil {
+		query = query.Where("vehicle_id = ?", *filter.VehicleID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("scheduled_end > ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("scheduled_start < ?", *filter.To)
+	}
+	if err := query.Order("scheduled_start asc, id asc").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// UpdateWorkOrder implements Repository.
+func (r *repository) UpdateWorkOrder(order *WorkOrder) error {
+	return r.db.Omit("Vehicle", "Plan", "CreatedBy").Save(order).Error
+}
+
+// HasWindowOverlap implements Repository.
+// Mengecek work order scheduled / in_progress yang jendelanya beririsan
+// dengan [start, end). end nil berarti tanpa batas akhir.
+func (r *repository) HasWindowOverlap(vehicleID uint, start time.Time, end *time.Time, excludeID uint) (bool, error) {
+	query := r.db.Model(&WorkOrder{}).
+		Where("vehicle_id = ?", vehicleID).
+		Where("status IN ?", []OrderStatus{OrderScheduled, OrderInProgress}).
+		Where("scheduled_end > ?", start)
+	if end != nil {
+		query = query.Where("scheduled_start < ?", *end)
+	}
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindWindows implements Repository.
+// Work order scheduled / in_progress beberapa kendaraan yang beririsan dengan [start, end).
+func (r *repository) FindWindows(vehicleIDs []uint, start, end time.Time) ([]*WorkOrder, error) {
+	var orders []*WorkOrder
+	if len(vehicleIDs) == 0 {
+		return orders, nil
+	}
+	err := r.db.Where("vehicle_id IN ?", vehicleIDs).
+		Where("status IN ?", []OrderStatus{OrderScheduled, OrderInProgress}).
+		Where("scheduled_end > ? AND scheduled_start < ?", start, end).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// CountInProgress implements Repository.
+func (r *repository) CountInProgress(vehicleID uint, excludeID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&WorkOrder{}).
+		Where("vehicle_id = ? AND status = ? AND id <> ?", vehicleID, OrderInProgress, excludeID).
+		Count(&count).Error
+	return count, err
+}
+
+// CountOpenSevereDamage implements Repository.
+// Tabel disebut sebagai string agar package ini tidak import package damage.
+func (r *repository) CountOpenSevereDamage(vehicleID uint) (int64, error) {
+	var count int64
+	err := r.db.Table("damage_reports").
+		Where("vehicle_id = ? AND severity = ? AND status = ?", vehicleID, "severe", "open").
+		Count(&count).Error
+	return count, err
+}
+
+// FindVehicleForUpdate implements Repository.
+// Mengunci baris kendaraan, sama seperti transaksi rent, supaya penjadwalan
+// servis dan reservasi untuk kendaraan yang sama tidak saling mendahului.
+func (r *repository) FindVehicleForUpdate(id uint) (*vehicle.Vehicle, error) {
+	var vh vehicle.Vehicle
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&vh, id).Error; err != nil {
+		return nil, err
+	}
+	return &vh, nil
+}
+
+// UpdateVehicle implements Repository.
+func (r *repository) UpdateVehicle(vh *vehicle.Vehicle) error {
+	return r.db.Save(vh).Error
+}
+
+// HasRentOverlap implements Repository.
+// Status rent disebut sebagai string agar package ini tidak import package rent.
+func (r *repository) HasRentOverlap(vehicleID uint, start, end time.Time) (bool, error) {
+	var count int64
+	err := r.db.Table("rents").
+		Where("vehicle_id = ?", vehicleID).
+		Where("status IN ?", []string{"reserved", "ongoing"}).
+		Where("COALESCE(planned_start_date, rent_date) < ?", end).
+		Where("planned_end_date IS NULL OR planned_end_date > ?", start).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Transaction implements Repository.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{db: tx})
+	})
+}
+
+// Tx implements Repository.
+func (r *repository) Tx() *gorm.DB {
+	return r.db
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
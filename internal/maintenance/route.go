@@ -0,0 +1,25 @@
+package maintenance
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupMaintenanceRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	maintenance := r.Group("/api/maintenance")
+	{
+		maintenance.POST("/plans", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreatePlan)
+		maintenance.GET("/plans", middlewares.Authenticate(cfg), ctrl.GetPlans)
+		maintenance.PUT("/plans/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdatePlan)
+		maintenance.GET("/due", middlewares.Authenticate(cfg), ctrl.GetDue)
+
+		maintenance.POST("/work-orders", middlewares.Authenticate(cfg), ctrl.CreateWorkOrder)
+		maintenance.GET("/work-orders", middlewares.Authenticate(cfg), ctrl.GetWorkOrders)
+		maintenance.GET("/work-orders/:id", middlewares.Authenticate(cfg), ctrl.GetWorkOrderByID)
+		maintenance.POST("/work-orders/:id/start", middlewares.Authenticate(cfg), ctrl.StartWorkOrder)
+		maintenance.POST("/work-orders/:id/complete", middlewares.Authenticate(cfg), ctrl.CompleteWorkOrder)
+		maintenance.POST("/work-orders/:id/cancel", middlewares.Authenticate(cfg), ctrl.CancelWorkOrder)
+	}
+}
@@ -0,0 +1,406 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/vehicle"
+	"go-rental/internal/vehicleclass"
+	"go-rental/pkg/config"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	// Plan
+	CreatePlan(req *PlanRequest) (*Plan, error)
+	GetAllPlans(filter *PlanFilter) ([]*Plan, error)
+	UpdatePlan(id uint, req *UpdatePlanRequest) (*Plan, error)
+	GetDue(filter *DueFilter) ([]*DueResponse, error)
+
+	// Work order
+	CreateWorkOrder(req *WorkOrderRequest, capacity ClassCapacity, createdBy uint) (*WorkOrder, error)
+	GetAllWorkOrders(filter *WorkOrderFilter) ([]*WorkOrder, error)
+	GetWorkOrderByID(id uint) (*WorkOrder, error)
+	StartWorkOrder(id uint) (*WorkOrder, error)
+	CompleteWorkOrder(id uint, req *CompleteWorkOrderRequest) (*WorkOrder, error)
+	CancelWorkOrder(id uint) (*WorkOrder, error)
+
+	// Dipanggil dari transaksi rent
+	CheckWindow(tx *gorm.DB, vehicleID uint, start time.Time, end *time.Time) error
+	FindWindows(tx *gorm.DB, vehicleIDs []uint, start, end time.Time) ([]*WorkOrder, error)
+}
+
+// ClassCapacity diimplementasikan oleh rent.Service yang menghitung sisa
+// kendaraan class, termasuk reservasi class yang belum di-assign.
+// Didefinisikan di sini agar package maintenance tidak perlu import
+// package rent (import cycle).
+type ClassCapacity interface {
+	ClassFreeCountTx(tx *gorm.DB, classID uint, from, to time.Time) (int, error)
+}
+
+type service struct {
+	repo        Repository
+	vehicleRepo vehicle.Repository
+	classes     vehicleclass.Service
+	cfg         *config.Config
+}
+
+// CreatePlan implements Service.
+func (s *service) CreatePlan(req *PlanRequest) (*Plan, error) {
+	if req.IntervalDays == 0 && req.IntervalKm == 0 {
+		return nil, errors.New("at least one of interval_days or interval_km is required")
+	}
+	vh, err := s.vehicleRepo.FindByID(req.VehicleID)
+	if err != nil {
+		return nil, errors.New("vehicle not found")
+	}
+
+	// Titik awal interval: servis terakhir yang diketahui, default kondisi sekarang
+	plan := &Plan{
+		VehicleID:           vh.ID,
+		Name:                req.Name,
+		IntervalDays:        req.IntervalDays,
+		IntervalKm:          req.IntervalKm,
+		LastServiceDate:     time.Now(),
+		LastServiceOdometer: vh.Odometer,
+		Active:              true,
+		Notes:               req.Notes,
+	}
+	if req.LastServiceDate != nil {
+		plan.LastServiceDate = *req.LastServiceDate
+	}
+	if req.LastServiceOdometer != nil {
+		plan.LastServiceOdometer = *req.LastServiceOdometer
+	}
+	if err := s.repo.CreatePlan(plan); err != nil {
+		return nil, fmt.Errorf("failed to create maintenance plan: %w", err)
+	}
+	return s.repo.FindPlanByID(plan.ID)
+}
+
+// GetAllPlans implements Service.
+func (s *service) GetAllPlans(filter *PlanFilter) ([]*Plan, error) {
+	plans, err := s.repo.FindPlans(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve maintenance plans: %w", err)
+	}
+	return plans, nil
+}
+
+// UpdatePlan implements Service.
+func (s *service) UpdatePlan(id uint, req *UpdatePlanRequest) (*Plan, error) {
+	plan, err := s.repo.FindPlanByID(id)
+	if err != nil {
+		return nil, errors.New("maintenance plan not found")
+	}
+
+	// Update only fields that are not nil
+	if req.Name != nil {
+		plan.Name = *req.Name
+	}
+	if req.IntervalDays != nil {
+		plan.IntervalDays = *req.IntervalDays
+	}
+	if req.IntervalKm != nil {
+		plan.IntervalKm = *req.IntervalKm
+	}
+	if req.Active != nil {
+		plan.Active = *req.Active
+	}
+	if req.Notes != nil {
+		plan.Notes = *req.Notes
+	}
+	if plan.IntervalDays == 0 && plan.IntervalKm == 0 {
+		return nil, errors.New("at least one of interval_days or interval_km is required")
+	}
+
+	if err := s.repo.UpdatePlan(plan); err != nil {
+		return nil, fmt.Errorf("failed to update maintenance plan: %w", err)
+	}
+	return plan, nil
+}
+
+// GetDue implements Service.
+// Hanya plan aktif yang sudah / hampir jatuh tempo, overdue di urutan pertama.
+func (s *service) GetDue(filter *DueFilter) ([]*DueResponse, error) {
+	withinDays, withinKm := 7, 500
+	if filter.WithinDays != nil {
+		withinDays = *filter.WithinDays
+	}
+	if filter.WithinKm != nil {
+		withinKm = *filter.WithinKm
+	}
+
+	active := true
+	plans, err := s.repo.FindPlans(&PlanFilter{VehicleID: filter.VehicleID, Active: &active})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve maintenance plans: %w", err)
+	}
+
+	now := time.Now()
+	overdue, due := []*DueResponse{}, []*DueResponse{}
+	for _, plan := range plans {
+		resp := evaluatePlan(plan, now, withinDays, withinKm)
+		switch resp.Status {
+		case DueOverdue:
+			overdue = append(overdue, resp)
+		case DueSoon:
+			due = append(due, resp)
+		}
+	}
+	return append(overdue, due...), nil
+}
+
+// CreateWorkOrder implements Service.
+// Jendela servis tidak boleh beririsan dengan reservasi / rent ongoing
+// maupun work order lain pada kendaraan yang sama, dan class kendaraan
+// harus tetap punya sisa untuk reservasi class selama jendela tersebut.
+func (s *service) CreateWorkOrder(req *WorkOrderRequest, capacity ClassCapacity, createdBy uint) (*WorkOrder, error) {
+	if !req.ScheduledEnd.After(req.ScheduledStart) {
+		return nil, errors.New("scheduled_end must be after scheduled_start")
+	}
+
+	order := &WorkOrder{
+		VehicleID:      req.VehicleID,
+		PlanID:         req.PlanID,
+		Title:          req.Title,
+		Description:    req.Description,
+		Status:         OrderScheduled,
+		ScheduledStart: req.ScheduledStart,
+		ScheduledEnd:   req.ScheduledEnd,
+		CreatedByID:    createdBy,
+	}
+	err := s.repo.Transaction(func(repo Repository) error {
+		// Class dikunci sebelum kendaraan, urutan yang sama dengan transaksi rent
+		peek, err := s.vehicleRepo.WithTx(repo.Tx()).FindByID(req.VehicleID)
+		if err != nil {
+			return errors.New("vehicle not found")
+		}
+		var class *vehicleclass.Class
+		if peek.ClassID != nil {
+			if class, err = s.classes.Lock(repo.Tx(), *peek.ClassID); err != nil {
+				return err
+			}
+		}
+
+		vh, err := repo.FindVehicleForUpdate(req.VehicleID)
+		if err != nil {
+			return errors.New("vehicle not found")
+		}
+		if req.PlanID != nil {
+			plan, err := repo.FindPlanByID(*req.PlanID)
+			if err != nil || plan.VehicleID != vh.ID {
+				return errors.New("maintenance plan not found for this vehicle")
+			}
+		}
+
+		overlap, err := repo.HasRentOverlap(vh.ID, req.ScheduledStart, req.ScheduledEnd)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return fmt.Errorf("vehicle %s has a reservation or ongoing rent in the requested window", vh.PlateNumber)
+		}
+		overlap, err = repo.HasWindowOverlap(vh.ID, req.ScheduledStart, &req.ScheduledEnd, 0)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return fmt.Errorf("vehicle %s already has maintenance scheduled in the requested window", vh.PlateNumber)
+		}
+
+		// Kendaraan yang sudah maintenance tidak dihitung di kapasitas class,
+		// selain itu jendela servis memakai satu kendaraan class
+		if class != nil && vh.Status != vehicle.StatusMaintenance {
+			free, err := capacity.ClassFreeCountTx(repo.Tx(), class.ID, req.ScheduledStart, req.ScheduledEnd)
+			if err != nil {
+				return err
+			}
+			if free < 1 {
+				return fmt.Errorf("vehicle class %s has no spare vehicle for class reservations in the requested window", class.Name)
+			}
+		}
+
+		if err := repo.CreateWorkOrder(order); err != nil {
+			return fmt.Errorf("failed to create work order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindWorkOrderByID(order.ID)
+}
+
+// GetAllWorkOrders implements Service.
+func (s *service) GetAllWorkOrders(filter *WorkOrderFilter) ([]*WorkOrder, error) {
+	orders, err := s.repo.FindWorkOrders(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve work orders: %w", err)
+	}
+	return orders, nil
+}
+
+// GetWorkOrderByID implements Service.
+func (s *service) GetWorkOrderByID(id uint) (*WorkOrder, error) {
+	order, err := s.repo.FindWorkOrderByID(id)
+	if err != nil {
+		return nil, errors.New("work order not found")
+	}
+	return order, nil
+}
+
+// StartWorkOrder implements Service.
+// Kendaraan masuk status maintenance, tidak bisa dimulai saat masih disewa.
+func (s *service) StartWorkOrder(id uint) (*WorkOrder, error) {
+	err := s.repo.Transaction(func(repo Repository) error {
+		order, err := repo.FindWorkOrderByIDForUpdate(id)
+		if err != nil {
+			return errors.New("work order not found")
+		}
+		if !canTransition(order.Status, OrderInProgress) {
+			return fmt.Errorf("cannot start work order with status %s", order.Status)
+		}
+		vh, err := repo.FindVehicleForUpdate(order.VehicleID)
+		if err != nil {
+			return errors.New("vehicle not found")
+		}
+		if vh.Status == vehicle.StatusRented {
+			return fmt.Errorf("vehicle %s is still rented", vh.PlateNumber)
+		}
+
+		now := time.Now()
+		order.Status = OrderInProgress
+		order.StartedAt = &now
+		if err := repo.UpdateWorkOrder(order); err != nil {
+			return errors.New("failed to update work order")
+		}
+		vh.Status = vehicle.StatusMaintenance
+		if err := repo.UpdateVehicle(vh); err != nil {
+			return errors.New("failed to update vehicle status")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindWorkOrderByID(id)
+}
+
+// CompleteWorkOrder implements Service.
+// Kendaraan kembali available (kecuali masih ada work order lain yang berjalan)
+// dan plan terkait dihitung ulang dari servis ini.
+func (s *service) CompleteWorkOrder(id uint, req *CompleteWorkOrderRequest) (*WorkOrder, error) {
+	err := s.repo.Transaction(func(repo Repository) error {
+		order, err := repo.FindWorkOrderByIDForUpdate(id)
+		if err != nil {
+			return errors.New("work order not found")
+		}
+		if !canTransition(order.Status, OrderCompleted) {
+			return fmt.Errorf("cannot complete work order with status %s", order.Status)
+		}
+		vh, err := repo.FindVehicleForUpdate(order.VehicleID)
+		if err != nil {
+			return errors.New("vehicle not found")
+		}
+		if *req.Odometer < vh.Odometer {
+			return errors.New("odometer cannot be lower than the vehicle's current odometer")
+		}
+
+		now := time.Now()
+		order.Status = OrderCompleted
+		order.CompletedAt = &now
+		order.Odometer = *req.Odometer
+		order.Cost = req.Cost
+		order.Notes = req.Notes
+		if err := repo.UpdateWorkOrder(order); err != nil {
+			return errors.New("failed to update work order")
+		}
+
+		if order.PlanID != nil {
+			plan, err := repo.FindPlanByID(*order.PlanID)
+			if err != nil {
+				return errors.New("maintenance plan not found")
+			}
+			plan.LastServiceDate = now
+			plan.LastServiceOdometer = order.Odometer
+			if err := repo.UpdatePlan(plan); err != nil {
+				return errors.New("failed to update maintenance plan")
+			}
+		}
+
+		others, err := repo.CountInProgress(vh.ID, order.ID)
+		if err != nil {
+			return err
+		}
+		// Kerusakan berat yang belum di-resolve tetap menahan kendaraan di maintenance
+		damaged, err := repo.CountOpenSevereDamage(vh.ID)
+		if err != nil {
+			return err
+		}
+		vh.Odometer = order.Odometer
+		if others == 0 && damaged == 0 && vh.Status == vehicle.StatusMaintenance {
+			vh.Status = vehicle.StatusAvailable
+		}
+		if err := repo.UpdateVehicle(vh); err != nil {
+			return errors.New("failed to update vehicle")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindWorkOrderByID(id)
+}
+
+// CancelWorkOrder implements Service.
+// Hanya work order yang belum dimulai, jendelanya langsung tidak memblokir reservasi lagi.
+func (s *service) CancelWorkOrder(id uint) (*WorkOrder, error) {
+	err := s.repo.Transaction(func(repo Repository) error {
+		order, err := repo.FindWorkOrderByIDForUpdate(id)
+		if err != nil {
+			return errors.New("work order not found")
+		}
+		if !canTransition(order.Status, OrderCancelled) {
+			return fmt.Errorf("cannot cancel work order with status %s", order.Status)
+		}
+		order.Status = OrderCancelled
+		if err := repo.UpdateWorkOrder(order); err != nil {
+			return errors.New("failed to update work order")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.FindWorkOrderByID(id)
+}
+
+// CheckWindow implements Service.
+// Dipanggil di transaksi rent setelah baris kendaraan dikunci.
+func (s *service) CheckWindow(tx *gorm.DB, vehicleID uint, start time.Time, end *time.Time) error {
+	overlap, err := s.repo.WithTx(tx).HasWindowOverlap(vehicleID, start, end, 0)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return errors.New("vehicle has scheduled maintenance in the requested period")
+	}
+	return nil
+}
+
+// FindWindows implements Service.
+// Dipakai cek kapasitas class: jendela servis mengurangi kendaraan yang bisa dipakai.
+func (s *service) FindWindows(tx *gorm.DB, vehicleIDs []uint, start, end time.Time) ([]*WorkOrder, error) {
+	return s.repo.WithTx(tx).FindWindows(vehicleIDs, start, end)
+}
+
+func NewService(repo Repository, vehicleRepo vehicle.Repository, classService vehicleclass.Service, cfg *config.Config) Service {
+	return &service{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+		classes:     classService,
+		cfg:         cfg,
+	}
+}
@@ -0,0 +1,192 @@
+package media
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// UploadMedia godoc
+// @Summary Upload vehicle media
+// @Description Upload photos (jpg/png, thumbnails generated) or documents (pdf/jpg/png), max 10MB per file. The first photo becomes the primary photo
+// @Tags Vehicle Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle ID"
+// @Param files formData file true "Files (repeat the field for multiple files)"
+// @Param kind formData string false "photo (default) or document"
+// @Param title formData string false "Title"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/media [post]
+func (ctrl *Controller) UploadMedia(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID")
+		return
+	}
+
+	var req UploadRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid multipart form: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	media, err := ctrl.service.Upload(uint(vehicleID), &req, form.File["files"], userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "media uploaded successfully", media)
+}
+
+// GetMedia godoc
+// @Summary Get vehicle media
+// @Description List photos and documents of a vehicle in display order
+// @Tags Vehicle Media
+// @Produce json
+// @Param id path int true "Vehicle ID"
+// @Param kind query string false "photo or document"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/media [get]
+func (ctrl *Controller) GetMedia(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID")
+		return
+	}
+
+	var filter MediaFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	media, err := ctrl.service.GetByVehicle(uint(vehicleID), &filter)
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "media retrieved successfully", media)
+}
+
+// ReorderMedia godoc
+// @Summary Reorder vehicle media
+// @Description Set the display order of all photos (or documents) of a vehicle
+// @Tags Vehicle Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle ID"
+// @Param data body ReorderRequest true "Media IDs in the new order"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/media/order [put]
+func (ctrl *Controller) ReorderMedia(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID")
+		return
+	}
+
+	var req ReorderRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	media, err := ctrl.service.Reorder(uint(vehicleID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "media reordered successfully", media)
+}
+
+// SetPrimaryMedia godoc
+// @Summary Set primary photo
+// @Description Make a photo the primary photo shown in the vehicle catalogue
+// @Tags Vehicle Media
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle ID"
+// @Param media_id path int true "Media ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/media/{media_id}/primary [post]
+func (ctrl *Controller) SetPrimaryMedia(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID")
+		return
+	}
+	mediaID, err := strconv.ParseUint(c.Param("media_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid media ID")
+		return
+	}
+
+	media, err := ctrl.service.SetPrimary(uint(vehicleID), uint(mediaID))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "primary photo updated successfully", media)
+}
+
+// DeleteMedia godoc
+// @Summary Delete vehicle media
+// @Description Delete a photo or document; deleting the primary photo promotes the next photo
+// @Tags Vehicle Media
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle ID"
+// @Param media_id path int true "Media ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle/{id}/media/{media_id} [delete]
+func (ctrl *Controller) DeleteMedia(c *gin.Context) {
+	vehicleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle ID")
+		return
+	}
+	mediaID, err := strconv.ParseUint(c.Param("media_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid media ID")
+		return
+	}
+
+	if err := ctrl.service.Delete(uint(vehicleID), uint(mediaID)); err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "media deleted successfully", nil)
+}
@@ -0,0 +1,81 @@
+package media
+
+import (
+	"bytes"
+	"go-rental/pkg/clock"
+	"go-rental/pkg/storage"
+	"image"
+	"image/jpeg"
+)
+
+// thumbnailSize adalah sisi terpanjang thumbnail dalam pixel
+const thumbnailSize = 320
+
+func toMediaResponse(m *VehicleMedia, store storage.Storage) *MediaResponse {
+	res := &MediaResponse{
+		ID:          m.ID,
+		VehicleID:   m.VehicleID,
+		Kind:        m.Kind,
+		URL:         store.URL(m.Key),
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Filename:    m.Filename,
+		Title:       m.Title,
+		Position:    m.Position,
+		IsPrimary:   m.IsPrimary,
+		CreatedAt:   clock.Format(m.CreatedAt),
+	}
+	if m.ThumbnailKey != "" {
+		res.ThumbnailURL = store.URL(m.ThumbnailKey)
+	}
+	return res
+}
+
+// makeThumbnail mengecilkan gambar agar sisi terpanjangnya maksimal
+// thumbnailSize lalu meng-encode sebagai JPEG. Gambar yang sudah kecil
+// tidak diperbesar. Transparansi (PNG) ditimpa ke latar putih.
+func makeThumbnail(src image.Image) ([]byte, error) {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	tw, th := w, h
+	if w >= h && w > thumbnailSize {
+		tw, th = thumbnailSize, max(1, h*thumbnailSize/w)
+	} else if h > w && h > thumbnailSize {
+		tw, th = max(1, w*thumbnailSize/h), thumbnailSize
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	// Area average: setiap pixel tujuan = rata-rata pixel sumber yang ditutupinya
+	for y := 0; y < th; y++ {
+		y0 := b.Min.Y + y*h/th
+		y1 := max(y0+1, b.Min.Y+(y+1)*h/th)
+		for x := 0; x < tw; x++ {
+			x0 := b.Min.X + x*w/tw
+			x1 := max(x0+1, b.Min.X+(x+1)*w/tw)
+
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// Warna sudah premultiplied, tambahkan putih sesuai sisa alpha
+					white := 0xffff - uint64(ca)
+					r += uint64(cr) + white
+					g += uint64(cg) + white
+					bl += uint64(cb) + white
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(bl / n >> 8)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
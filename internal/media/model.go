@@ -0,0 +1,68 @@
+package media
+
+import (
+	"go-rental/internal/user"
+	"time"
+)
+
+type Kind string
+
+const (
+	KindPhoto    Kind = "photo"
+	KindDocument Kind = "document"
+)
+
+// VehicleMedia adalah foto / dokumen kendaraan. Key dan ThumbnailKey
+// relatif terhadap storage yang dipakai (lokal atau S3-compatible).
+type VehicleMedia struct {
+	ID           uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleID    uint      `json:"vehicle_id" gorm:"index"`
+	Kind         Kind      `json:"kind" gorm:"type:enum('photo', 'document');default:'photo'"`
+	Key          string    `json:"-"`
+	ThumbnailKey string    `json:"-"` // hanya untuk foto
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	Filename     string    `json:"filename"` // nama file asli dari client
+	Title        string    `json:"title"`
+	Position     int       `json:"position"` // urutan tampil, mulai dari 0
+	IsPrimary    bool      `json:"is_primary"`
+	UploadedByID uint      `json:"uploaded_by_id"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	UploadedBy user.User `json:"-" gorm:"foreignKey:UploadedByID"`
+}
+
+func (VehicleMedia) TableName() string {
+	return "vehicle_media"
+}
+
+type UploadRequest struct {
+	Kind  string `form:"kind" binding:"omitempty,oneof=photo document"` // default: photo
+	Title string `form:"title"`
+}
+
+// ReorderRequest berisi ID media dalam urutan baru, harus mencakup
+// semua media dengan kind yang sama milik kendaraan tersebut.
+type ReorderRequest struct {
+	Kind string `json:"kind" form:"kind" binding:"omitempty,oneof=photo document"` // default: photo
+	IDs  []uint `json:"ids" form:"ids" binding:"required,min=1"`
+}
+
+type MediaFilter struct {
+	Kind *string `form:"kind" binding:"omitempty,oneof=photo document"`
+}
+
+type MediaResponse struct {
+	ID           uint   `json:"id"`
+	VehicleID    uint   `json:"vehicle_id"`
+	Kind         Kind   `json:"kind"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	Filename     string `json:"filename"`
+	Title        string `json:"title"`
+	Position     int    `json:"position"`
+	IsPrimary    bool   `json:"is_primary"`
+	CreatedAt    string `json:"created_at"`
+}
@@ -0,0 +1,113 @@
+package media
+
+import (
+	"go-rental/internal/vehicle"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Create(media *VehicleMedia) error
+	FindByID(vehicleID, id uint) (*VehicleMedia, error)
+	FindByVehicle(vehicleID uint, kind *Kind) ([]*VehicleMedia, error)
+	Update(media *VehicleMedia) error
+	Delete(media *VehicleMedia) error
+	MaxPosition(vehicleID uint, kind Kind) (int, error)
+
+	// LockVehicle mengunci baris kendaraan agar upload / urutan / foto utama
+	// untuk kendaraan yang sama diproses bergantian
+	LockVehicle(vehicleID uint) (*vehicle.Vehicle, error)
+	// SetVehiclePhoto menyalin URL foto utama ke kolom vehicles untuk katalog
+	SetVehiclePhoto(vehicleID uint, photoURL, thumbnailURL string) error
+
+	Transaction(fn func(repo Repository) error) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(media *VehicleMedia) error {
+	return r.db.Omit("UploadedBy").Create(media).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(vehicleID, id uint) (*VehicleMedia, error) {
+	var media VehicleMedia
+	if err := r.db.Where("vehicle_id = ?", vehicleID).First(&media, id).Error; err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+// FindByVehicle implements Repository.
+func (r *repository) FindByVehicle(vehicleID uint, kind *Kind) ([]*VehicleMedia, error) {
+	var media []*VehicleMedia
+	query := r.db.Where("vehicle_id = ?", vehicleID)
+	if kind != nil {
+		query = query.Where("kind = ?", *kind)
+	}
+	if err := query.Order("kind DESC, position ASC, id ASC").Find(&media).Error; err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(media *VehicleMedia) error {
+	return r.db.Omit("UploadedBy").Save(media).Error
+}
+
+// Delete implements Repository.
+func (r *repository) Delete(media *VehicleMedia) error {
+	return r.db.Delete(media).Error
+}
+
+// MaxPosition implements Repository.
+// Mengembalikan -1 jika belum ada media.
+func (r *repository) MaxPosition(vehicleID uint, kind Kind) (int, error) {
+	var max *int
+	err := r.db.Model(&VehicleMedia{}).
+		Where("vehicle_id = ? AND kind = ?", vehicleID, kind).
+		Select("MAX(position)").Scan(&max).Error
+	if err != nil {
+		return 0, err
+	}
+	if max == nil {
+		return -1, nil
+	}
+	return *max, nil
+}
+
+// LockVehicle implements Repository.
+func (r *repository) LockVehicle(vehicleID uint) (*vehicle.Vehicle, error) {
+	var vh vehicle.Vehicle
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&vh, vehicleID).Error; err != nil {
+		return nil, err
+	}
+	return &vh, nil
+}
+
+// SetVehiclePhoto implements Repository.
+func (r *repository) SetVehiclePhoto(vehicleID uint, photoURL, thumbnailURL string) error {
+	return r.db.Model(&vehicle.Vehicle{}).Where("id = ?", vehicleID).
+		UpdateColumns(map[string]interface{}{
+			"photo_url":     photoURL,
+			"thumbnail_url": thumbnailURL,
+		}).Error
+}
+
+// Transaction implements Repository.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{db: tx})
+	})
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
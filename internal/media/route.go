@@ -0,0 +1,19 @@
+package media
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupMediaRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	media := r.Group("/api/vehicle")
+	{
+		media.POST("/:id/media", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UploadMedia)
+		media.GET("/:id/media", ctrl.GetMedia)
+		media.PUT("/:id/media/order", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.ReorderMedia)
+		media.POST("/:id/media/:media_id/primary", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.SetPrimaryMedia)
+		media.DELETE("/:id/media/:media_id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteMedia)
+	}
+}
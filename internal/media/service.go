@@ -0,0 +1,404 @@
+package media
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/storage"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"time"
+)
+
+const (
+	maxFileSize    = 10 << 20
+	maxFilesPerReq = 10
+	// maxImagePixels membatasi ukuran gambar setelah di-decode (40 MP),
+	// file kecil bisa berisi dimensi raksasa yang menghabiskan memori
+	maxImagePixels = 40_000_000
+)
+
+type Service interface {
+	Upload(vehicleID uint, req *UploadRequest, files []*multipart.FileHeader, uploadedBy uint) ([]*MediaResponse, error)
+	GetByVehicle(vehicleID uint, filter *MediaFilter) ([]*MediaResponse, error)
+	Reorder(vehicleID uint, req *ReorderRequest) ([]*MediaResponse, error)
+	SetPrimary(vehicleID, mediaID uint) (*MediaResponse, error)
+	Delete(vehicleID, mediaID uint) error
+}
+
+type service struct {
+	repo        Repository
+	vehicleRepo vehicle.Repository
+	store       storage.Storage
+}
+
+// upload adalah file yang sudah divalidasi dan siap disimpan
+type upload struct {
+	filename    string
+	contentType string
+	data        []byte
+	ext         string
+	thumbnail   []byte
+}
+
+// Upload implements Service.
+// Semua file divalidasi dulu, baru disimpan. Foto pertama kendaraan
+// otomatis menjadi foto utama.
+func (s *service) Upload(vehicleID uint, req *UploadRequest, files []*multipart.FileHeader, uploadedBy uint) ([]*MediaResponse, error) {
+	if _, err := s.vehicleRepo.FindByID(vehicleID); err != nil {
+		return nil, errors.New("vehicle not found")
+	}
+	kind := KindPhoto
+	if req.Kind != "" {
+		kind = Kind(req.Kind)
+	}
+	if len(files) == 0 {
+		return nil, errors.New("at least one file is required")
+	}
+	if len(files) > maxFilesPerReq {
+		return nil, fmt.Errorf("too many files, max %d per request", maxFilesPerReq)
+	}
+
+	uploads := make([]*upload, 0, len(files))
+	for _, fh := range files {
+		u, err := prepareUpload(fh, kind)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fh.Filename, err)
+		}
+		uploads = append(uploads, u)
+	}
+
+	// Simpan ke storage di luar transaksi, dihapus lagi jika insert gagal
+	var stored []string
+	cleanup := func() {
+		for _, key := range stored {
+			if err := s.store.Delete(key); err != nil {
+				log.Printf("failed to delete media object %s: %v", key, err)
+			}
+		}
+	}
+	records := make([]*VehicleMedia, 0, len(uploads))
+	for _, u := range uploads {
+		name := newObjectName()
+		m := &VehicleMedia{
+			VehicleID:    vehicleID,
+			Kind:         kind,
+			Key:          fmt.Sprintf("vehicles/%d/%ss/%s%s", vehicleID, kind, name, u.ext),
+			ContentType:  u.contentType,
+			Size:         int64(len(u.data)),
+			Filename:     u.filename,
+			Title:        req.Title,
+			UploadedByID: uploadedBy,
+		}
+		if err := s.store.Put(m.Key, u.data, u.contentType); err != nil {
+			cleanup()
+			return nil, fmt.Errorf("failed to store file: %w", err)
+		}
+		stored = append(stored, m.Key)
+		if u.thumbnail != nil {
+			m.ThumbnailKey = fmt.Sprintf("vehicles/%d/%ss/%s-thumb.jpg", vehicleID, kind, name)
+			if err := s.store.Put(m.ThumbnailKey, u.thumbnail, "image/jpeg"); err != nil {
+				cleanup()
+				return nil, fmt.Errorf("failed to store thumbnail: %w", err)
+			}
+			stored = append(stored, m.ThumbnailKey)
+		}
+		records = append(records, m)
+	}
+
+	err := s.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.LockVehicle(vehicleID); err != nil {
+			return errors.New("vehicle not found")
+		}
+		position, err := repo.MaxPosition(vehicleID, kind)
+		if err != nil {
+			return err
+		}
+		hasPrimary := false
+		if kind == KindPhoto {
+			existing, err := repo.FindByVehicle(vehicleID, &kind)
+			if err != nil {
+				return err
+			}
+			for _, m := range existing {
+				hasPrimary = hasPrimary || m.IsPrimary
+			}
+		}
+		for _, m := range records {
+			position++
+			m.Position = position
+			if kind == KindPhoto && !hasPrimary {
+				m.IsPrimary = true
+				hasPrimary = true
+			}
+			if err := repo.Create(m); err != nil {
+				return err
+			}
+			if m.IsPrimary {
+				if err := repo.SetVehiclePhoto(vehicleID, s.store.URL(m.Key), s.store.URL(m.ThumbnailKey)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	result := make([]*MediaResponse, len(records))
+	for i, m := range records {
+		result[i] = toMediaResponse(m, s.store)
+	}
+	return result, nil
+}
+
+// GetByVehicle implements Service.
+func (s *service) GetByVehicle(vehicleID uint, filter *MediaFilter) ([]*MediaResponse, error) {
+	if _, err := s.vehicleRepo.FindByID(vehicleID); err != nil {
+		return nil, errors.New("vehicle not found")
+	}
+	var kind *Kind
+	if filter.Kind != nil {
+		k := Kind(*filter.Kind)
+		kind = &k
+	}
+	media, err := s.repo.FindByVehicle(vehicleID, kind)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*MediaResponse, len(media))
+	for i, m := range media {
+		result[i] = toMediaResponse(m, s.store)
+	}
+	return result, nil
+}
+
+// Reorder implements Service.
+// IDs harus berisi tepat semua media dengan kind tersebut, tanpa duplikat.
+func (s *service) Reorder(vehicleID uint, req *ReorderRequest) ([]*MediaResponse, error) {
+	kind := KindPhoto
+	if req.Kind != "" {
+		kind = Kind(req.Kind)
+	}
+
+	var media []*VehicleMedia
+	err := s.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.LockVehicle(vehicleID); err != nil {
+			return errors.New("vehicle not found")
+		}
+		existing, err := repo.FindByVehicle(vehicleID, &kind)
+		if err != nil {
+			return err
+		}
+		byID := make(map[uint]*VehicleMedia, len(existing))
+		for _, m := range existing {
+			byID[m.ID] = m
+		}
+		if len(req.IDs) != len(existing) {
+			return fmt.Errorf("ids must list all %d %s media of this vehicle", len(existing), kind)
+		}
+		media = make([]*VehicleMedia, 0, len(req.IDs))
+		for position, id := range req.IDs {
+			m, ok := byID[id]
+			if !ok {
+				return fmt.Errorf("media %d not found or listed twice", id)
+			}
+			delete(byID, id)
+			if m.Position != position {
+				m.Position = position
+				if err := repo.Update(m); err != nil {
+					return err
+				}
+			}
+			media = append(media, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*MediaResponse, len(media))
+	for i, m := range media {
+		result[i] = toMediaResponse(m, s.store)
+	}
+	return result, nil
+}
+
+// SetPrimary implements Service.
+func (s *service) SetPrimary(vehicleID, mediaID uint) (*MediaResponse, error) {
+	var primary *VehicleMedia
+	err := s.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.LockVehicle(vehicleID); err != nil {
+			return errors.New("vehicle not found")
+		}
+		m, err := repo.FindByID(vehicleID, mediaID)
+		if err != nil {
+			return errors.New("media not found")
+		}
+		if m.Kind != KindPhoto {
+			return errors.New("only photos can be set as primary")
+		}
+		kind := KindPhoto
+		photos, err := repo.FindByVehicle(vehicleID, &kind)
+		if err != nil {
+			return err
+		}
+		for _, p := range photos {
+			if p.IsPrimary != (p.ID == m.ID) {
+				p.IsPrimary = p.ID == m.ID
+				if err := repo.Update(p); err != nil {
+					return err
+				}
+			}
+			if p.ID == m.ID {
+				primary = p
+			}
+		}
+		return repo.SetVehiclePhoto(vehicleID, s.store.URL(primary.Key), s.store.URL(primary.ThumbnailKey))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toMediaResponse(primary, s.store), nil
+}
+
+// Delete implements Service.
+// Jika foto utama dihapus, foto berikutnya (urutan teratas) menjadi foto utama.
+func (s *service) Delete(vehicleID, mediaID uint) error {
+	var deleted *VehicleMedia
+	err := s.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.LockVehicle(vehicleID); err != nil {
+			return errors.New("vehicle not found")
+		}
+		m, err := repo.FindByID(vehicleID, mediaID)
+		if err != nil {
+			return errors.New("media not found")
+		}
+		if err := repo.Delete(m); err != nil {
+			return err
+		}
+		deleted = m
+		if !m.IsPrimary {
+			return nil
+		}
+
+		kind := KindPhoto
+		photos, err := repo.FindByVehicle(vehicleID, &kind)
+		if err != nil {
+			return err
+		}
+		if len(photos) == 0 {
+			return repo.SetVehiclePhoto(vehicleID, "", "")
+		}
+		next := photos[0]
+		next.IsPrimary = true
+		if err := repo.Update(next); err != nil {
+			return err
+		}
+		return repo.SetVehiclePhoto(vehicleID, s.store.URL(next.Key), s.store.URL(next.ThumbnailKey))
+	})
+	if err != nil {
+		return err
+	}
+
+	// Object dihapus setelah commit; jika gagal hanya tersisa file yatim di storage
+	for _, key := range []string{deleted.Key, deleted.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := s.store.Delete(key); err != nil {
+			log.Printf("failed to delete media object %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// prepareUpload membaca dan memvalidasi satu file. Jenis file ditentukan
+// dari isinya, bukan dari ekstensi. Foto: jpg/png, dokumen: pdf/jpg/png.
+func prepareUpload(fh *multipart.FileHeader, kind Kind) (*upload, error) {
+	if fh.Size > maxFileSize {
+		return nil, errors.New("file is too large (max 10MB)")
+	}
+	src, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	data, err := io.ReadAll(io.LimitReader(src, maxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxFileSize {
+		return nil, errors.New("file is too large (max 10MB)")
+	}
+
+	u := &upload{
+		filename:    filepath.Base(fh.Filename),
+		contentType: http.DetectContentType(data),
+		data:        data,
+	}
+	switch u.contentType {
+	case "image/jpeg":
+		u.ext = ".jpg"
+	case "image/png":
+		u.ext = ".png"
+	case "application/pdf":
+		if kind == KindPhoto {
+			return nil, errors.New("photo must be jpg or png")
+		}
+		u.ext = ".pdf"
+		return u, nil
+	default:
+		if kind == KindPhoto {
+			return nil, errors.New("photo must be jpg or png")
+		}
+		return nil, errors.New("document must be pdf, jpg or png")
+	}
+
+	// Baca header dulu, dimensi dicek sebelum pixel di-decode
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("file is not a valid image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, fmt.Errorf("image dimensions %dx%d are too large (max %d pixels)", cfg.Width, cfg.Height, maxImagePixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("file is not a valid image")
+	}
+	if kind == KindPhoto {
+		if u.thumbnail, err = makeThumbnail(img); err != nil {
+			return nil, fmt.Errorf("failed to create thumbnail: %w", err)
+		}
+	}
+	return u, nil
+}
+
+// newObjectName membuat nama object unik: <unix>-<8 byte acak>
+func newObjectName() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return fmt.Sprintf("%d-%s", time.Now().Unix(), hex.EncodeToString(b))
+}
+
+func NewService(repo Repository, vehicleRepo vehicle.Repository, store storage.Storage) Service {
+	return &service{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+		store:       store,
+	}
+}
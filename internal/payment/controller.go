@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// RecordEntry godoc
+// @Summary Record ledger entry
+// @Description Record a charge, deposit, payment or refund on a rent. Charges are only accepted on reserved or ongoing rents
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body PaymentRequest true "Ledger entry data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/payment/ [post]
+func (ctrl *Controller) RecordEntry(c *gin.Context) {
+	var req PaymentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	ledger, err := ctrl.service.RecordEntry(&req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "ledger entry recorded successfully", ledger)
+}
+
+// GetLedger godoc
+// @Summary Get rent ledger
+// @Description Retrieve charges, payments and outstanding balance of a rent
+// @Tags Payment
+// @Produce json
+// @Security BearerAuth
+// @Param rent_id path int true "Rent ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/payment/rent/{rent_id} [get]
+func (ctrl *Controller) GetLedger(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("rent_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	ledger, err := ctrl.service.GetLedger(uint(rentID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "ledger retrieved successfully", ledger)
+}
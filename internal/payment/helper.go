@@ -0,0 +1,41 @@
+package payment
+
+import (
+	"go-rental/pkg/clock"
+	"math"
+)
+
+func toPaymentResponse(p *Payment) *PaymentResponse {
+	return &PaymentResponse{
+		ID:        p.ID,
+		RentID:    p.RentID,
+		Type:      p.Type,
+		Method:    p.Method,
+		Amount:    p.Amount,
+		Reference: p.Reference,
+		Notes:     p.Notes,
+		CreatedBy: p.CreatedBy.Name,
+		CreatedAt: clock.Format(p.CreatedAt),
+	}
+}
+
+// buildLedger menjumlahkan pergerakan uang terhadap total tagihan rent.
+// Deposit ikut mengurangi saldo karena uangnya sudah dipegang rental.
+func buildLedger(ledger *LedgerResponse, payments []*Payment) {
+	for _, p := range payments {
+		switch p.Type {
+		case EntryDeposit:
+			ledger.TotalDeposits += p.Amount
+		case EntryPayment:
+			ledger.TotalPayments += p.Amount
+		case EntryRefund:
+			ledger.TotalRefunds += p.Amount
+		}
+		ledger.Entries = append(ledger.Entries, toPaymentResponse(p))
+	}
+	ledger.Balance = round2(ledger.TotalCharges - ledger.TotalDeposits - ledger.TotalPayments + ledger.TotalRefunds)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
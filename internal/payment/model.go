@@ -0,0 +1,74 @@
+package payment
+
+import (
+	"go-rental/internal/rent"
+	"go-rental/internal/user"
+	"time"
+)
+
+type EntryType string
+type Method string
+
+const (
+	EntryCharge  EntryType = "charge"
+	EntryDeposit EntryType = "deposit"
+	EntryPayment EntryType = "payment"
+	EntryRefund  EntryType = "refund"
+)
+
+const (
+	MethodCash     Method = "cash"
+	MethodTransfer Method = "transfer"
+	MethodCard     Method = "card"
+)
+
+// Payment adalah satu pergerakan uang (deposit, pembayaran, refund) pada rent.
+// Tagihan (charge) tidak disimpan di sini melainkan di rent_charges.
+type Payment struct {
+	ID          uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID      uint      `json:"rent_id" gorm:"index"`
+	Type        EntryType `json:"type" gorm:"type:enum('deposit', 'payment', 'refund')"`
+	Method      Method    `json:"method" gorm:"type:enum('cash', 'transfer', 'card')"`
+	Amount      float64   `json:"amount"`
+	Reference   string    `json:"reference"`
+	Notes       string    `json:"notes"`
+	CreatedByID uint      `json:"created_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Relations
+	CreatedBy user.User `json:"created_by" gorm:"foreignKey:CreatedByID"`
+}
+
+type PaymentRequest struct {
+	RentID      uint    `json:"rent_id" form:"rent_id" binding:"required"`
+	Type        string  `json:"type" form:"type" binding:"required,oneof=charge deposit payment refund"`
+	Method      string  `json:"method" form:"method" binding:"omitempty,oneof=cash transfer card"`
+	Amount      float64 `json:"amount" form:"amount" binding:"required,gt=0"`
+	Reference   string  `json:"reference" form:"reference"`
+	Description string  `json:"description" form:"description"` // Keterangan untuk charge
+	Notes       string  `json:"notes" form:"notes"`
+}
+
+type PaymentResponse struct {
+	ID        uint      `json:"id"`
+	RentID    uint      `json:"rent_id"`
+	Type      EntryType `json:"type"`
+	Method    Method    `json:"method"`
+	Amount    float64   `json:"amount"`
+	Reference string    `json:"reference"`
+	Notes     string    `json:"notes"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt string    `json:"created_at"`
+}
+
+// LedgerResponse adalah ringkasan tagihan dan pembayaran satu rent
+type LedgerResponse struct {
+	RentID        uint               `json:"rent_id"`
+	Charges       []rent.RentCharge  `json:"charges"`
+	Entries       []*PaymentResponse `json:"entries"`
+	TotalCharges  float64            `json:"total_charges"`
+	TotalDeposits float64            `json:"total_deposits"`
+	TotalPayments float64            `json:"total_payments"`
+	TotalRefunds  float64            `json:"total_refunds"`
+	Balance       float64            `json:"balance"` // > 0 berarti masih kurang bayar
+}
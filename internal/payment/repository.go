@@ -0,0 +1,36 @@
+package payment
+
+import "gorm.io/gorm"
+
+type Repository interface {
+	Create(payment *Payment) error
+	FindByRentID(rentID uint) ([]*Payment, error)
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(payment *Payment) error {
+	return r.db.Create(payment).Error
+}
+
+// FindByRentID implements Repository.
+func (r *repository) FindByRentID(rentID uint) ([]*Payment, error) {
+	var payments []*Payment
+	if err := r.db.Preload("CreatedBy").Where("rent_id = ?", rentID).Order("created_at asc, id asc").Find(&payments).Error; err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,16 @@
+package payment
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPaymentRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	payment := r.Group("/api/payment")
+	{
+		payment.POST("/", middlewares.Authenticate(cfg), ctrl.RecordEntry)
+		payment.GET("/rent/:rent_id", middlewares.Authenticate(cfg), ctrl.GetLedger)
+	}
+}
@@ -0,0 +1,157 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/pricing"
+	"go-rental/internal/rent"
+	"go-rental/pkg/config"
+)
+
+type Service interface {
+	RecordEntry(req *PaymentRequest, createdBy uint) (*LedgerResponse, error)
+	GetLedger(rentID uint) (*LedgerResponse, error)
+	GetReceivedAmount(rentID uint) (float64, error)
+}
+
+type service struct {
+	repo     Repository
+	rentRepo rent.Repository
+	rentUow  rent.UnitOfWork
+}
+
+// RecordEntry implements Service.
+func (s *service) RecordEntry(req *PaymentRequest, createdBy uint) (*LedgerResponse, error) {
+	entryType := EntryType(req.Type)
+
+	// Charge manual masuk ke rincian tagihan rent, bukan ke tabel payments
+	if entryType == EntryCharge {
+		if err := s.addCharge(req); err != nil {
+			return nil, err
+		}
+		return s.GetLedger(req.RentID)
+	}
+
+	if req.Method == "" {
+		return nil, errors.New("method is required for deposit, payment and refund")
+	}
+
+	// Row rent dikunci supaya refund paralel tidak bisa melewati uang yang diterima
+	err := s.rentUow.Do(func(repos *rent.Repositories) error {
+		if _, err := repos.Rent.FindByIDForUpdate(req.RentID); err != nil {
+			return errors.New("rent not found")
+		}
+		repo := s.repo.WithTx(repos.Tx)
+
+		// Refund tidak boleh melebihi uang yang sudah diterima
+		if entryType == EntryRefund {
+			payments, err := repo.FindByRentID(req.RentID)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve payments: %w", err)
+			}
+			ledger := &LedgerResponse{}
+			buildLedger(ledger, payments)
+			received := round2(ledger.TotalDeposits + ledger.TotalPayments - ledger.TotalRefunds)
+			if req.Amount > received {
+				return fmt.Errorf("refund exceeds received amount (%.2f)", received)
+			}
+		}
+
+		payment := &Payment{
+			RentID:      req.RentID,
+			Type:        entryType,
+			Method:      Method(req.Method),
+			Amount:      req.Amount,
+			Reference:   req.Reference,
+			Notes:       req.Notes,
+			CreatedByID: createdBy,
+		}
+		if err := repo.Create(payment); err != nil {
+			return fmt.Errorf("failed to record payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetLedger(req.RentID)
+}
+
+// addCharge menambah charge manual dan menghitung ulang TotalPrice rent
+func (s *service) addCharge(req *PaymentRequest) error {
+	return s.rentUow.Do(func(repos *rent.Repositories) error {
+		rt, err := repos.Rent.FindByIDForUpdate(req.RentID)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		// Rent completed sudah punya invoice final dari TotalPrice, jadi hanya
+		// rent yang masih berjalan yang bisa ditambah tagihan
+		if rt.Status != rent.StatusReserved && rt.Status != rent.StatusOngoing {
+			return fmt.Errorf("cannot add charge to %s rent, only reserved or ongoing rent can be charged", rt.Status)
+		}
+
+		description := req.Description
+		if description == "" {
+			description = req.Notes
+		}
+		charge := &rent.RentCharge{
+			RentID:      rt.ID,
+			Type:        pricing.ChargeManual,
+			Description: description,
+			Quantity:    1,
+			UnitPrice:   req.Amount,
+			Amount:      req.Amount,
+		}
+		if err := repos.Rent.CreateCharges([]*rent.RentCharge{charge}); err != nil {
+			return errors.New("failed to save rent charge")
+		}
+		if rt.TotalPrice, err = repos.Rent.SumCharges(rt.ID); err != nil {
+			return errors.New("failed to calculate total price")
+		}
+		if err := repos.Rent.Update(rt); err != nil {
+			return errors.New("failed to update rent")
+		}
+		return nil
+	})
+}
+
+// GetLedger implements Service.
+func (s *service) GetLedger(rentID uint) (*LedgerResponse, error) {
+	rt, err := s.rentRepo.FindByID(rentID)
+	if err != nil {
+		return nil, errors.New("rent not found")
+	}
+	payments, err := s.repo.FindByRentID(rentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve payments: %w", err)
+	}
+
+	ledger := &LedgerResponse{
+		RentID:       rt.ID,
+		Charges:      rt.Charges,
+		TotalCharges: rt.TotalPrice,
+	}
+	buildLedger(ledger, payments)
+	return ledger, nil
+}
+
+// GetReceivedAmount implements Service.
+// Uang bersih yang sudah diterima: deposit + pembayaran - refund.
+func (s *service) GetReceivedAmount(rentID uint) (float64, error) {
+	payments, err := s.repo.FindByRentID(rentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve payments: %w", err)
+	}
+	ledger := &LedgerResponse{}
+	buildLedger(ledger, payments)
+	return round2(ledger.TotalDeposits + ledger.TotalPayments - ledger.TotalRefunds), nil
+}
+
+func NewService(repo Repository, rentRepo rent.Repository, rentUow rent.UnitOfWork, cfg *config.Config) Service {
+	return &service{
+		repo:     repo,
+		rentRepo: rentRepo,
+		rentUow:  rentUow,
+	}
+}
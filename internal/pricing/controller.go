@@ -0,0 +1,311 @@
+package pricing
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// GetQuote godoc
+// @Summary Get price quote
+// @Description Calculate an itemised price for a vehicle and period
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Param vehicle_id query int true "Vehicle ID"
+// @Param from query string true "Period start (RFC3339)"
+// @Param to query string true "Period end (RFC3339)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/quote [get]
+func (ctrl *Controller) GetQuote(c *gin.Context) {
+	var req QuoteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid query parameters: "+err.Error())
+		return
+	}
+
+	quote, err := ctrl.service.GetQuote(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "quote calculated successfully", quote)
+}
+
+// CreateRule godoc
+// @Summary Create pricing rule
+// @Description Create a pricing rule for a vehicle type or a single vehicle
+// @Tags Pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body PricingRuleRequest true "Pricing rule data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/rules [post]
+func (ctrl *Controller) CreateRule(c *gin.Context) {
+	var req PricingRuleRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	rule, err := ctrl.service.CreateRule(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "pricing rule created successfully", rule)
+}
+
+// GetRules godoc
+// @Summary Get pricing rules
+// @Description Retrieve all pricing rules
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/pricing/rules [get]
+func (ctrl *Controller) GetRules(c *gin.Context) {
+	rules, err := ctrl.service.GetAllRules()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "pricing rules retrieved successfully", rules)
+}
+
+// UpdateRule godoc
+// @Summary Update pricing rule
+// @Description Update a pricing rule by ID
+// @Tags Pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Pricing rule ID"
+// @Param data body UpdatePricingRuleRequest true "Pricing rule update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/rules/{id} [put]
+func (ctrl *Controller) UpdateRule(c *gin.Context) {
+	ruleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid pricing rule ID")
+		return
+	}
+	var req UpdatePricingRuleRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	rule, err := ctrl.service.UpdateRule(uint(ruleID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "pricing rule updated successfully", rule)
+}
+
+// DeleteRule godoc
+// @Summary Delete pricing rule
+// @Description Delete a pricing rule by ID
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Pricing rule ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/rules/{id} [delete]
+func (ctrl *Controller) DeleteRule(c *gin.Context) {
+	ruleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid pricing rule ID")
+		return
+	}
+	if err := ctrl.service.DeleteRule(uint(ruleID)); err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "pricing rule deleted successfully", nil)
+}
+
+// CreateCancellationPolicy godoc
+// @Summary Create cancellation policy
+// @Description Create a lead-time based cancellation policy for a vehicle type, or the default policy when vehicle_type is empty
+// @Tags Pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body CancellationPolicyRequest true "Cancellation policy data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/cancellation-policies [post]
+func (ctrl *Controller) CreateCancellationPolicy(c *gin.Context) {
+	var req CancellationPolicyRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	policy, err := ctrl.service.CreateCancellationPolicy(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "cancellation policy created successfully", policy)
+}
+
+// GetCancellationPolicies godoc
+// @Summary Get cancellation policies
+// @Description Retrieve all cancellation policies with their tiers
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/pricing/cancellation-policies [get]
+func (ctrl *Controller) GetCancellationPolicies(c *gin.Context) {
+	policies, err := ctrl.service.GetAllCancellationPolicies()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "cancellation policies retrieved successfully", policies)
+}
+
+// UpdateCancellationPolicy godoc
+// @Summary Update cancellation policy
+// @Description Replace a cancellation policy and its tiers
+// @Tags Pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Cancellation policy ID"
+// @Param data body CancellationPolicyRequest true "Cancellation policy data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/cancellation-policies/{id} [put]
+func (ctrl *Controller) UpdateCancellationPolicy(c *gin.Context) {
+	policyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid cancellation policy ID")
+		return
+	}
+	var req CancellationPolicyRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	policy, err := ctrl.service.UpdateCancellationPolicy(uint(policyID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "cancellation policy updated successfully", policy)
+}
+
+// DeleteCancellationPolicy godoc
+// @Summary Delete cancellation policy
+// @Description Delete a cancellation policy by ID
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Cancellation policy ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/cancellation-policies/{id} [delete]
+func (ctrl *Controller) DeleteCancellationPolicy(c *gin.Context) {
+	policyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid cancellation policy ID")
+		return
+	}
+	if err := ctrl.service.DeleteCancellationPolicy(uint(policyID)); err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "cancellation policy deleted successfully", nil)
+}
+
+// CreateHoliday godoc
+// @Summary Create holiday
+// @Description Register a holiday used for holiday surcharges
+// @Tags Pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body HolidayRequest true "Holiday data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/holidays [post]
+func (ctrl *Controller) CreateHoliday(c *gin.Context) {
+	var req HolidayRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	holiday, err := ctrl.service.CreateHoliday(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "holiday created successfully", holiday)
+}
+
+// GetHolidays godoc
+// @Summary Get holidays
+// @Description Retrieve all holidays
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/pricing/holidays [get]
+func (ctrl *Controller) GetHolidays(c *gin.Context) {
+	holidays, err := ctrl.service.GetAllHolidays()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "holidays retrieved successfully", holidays)
+}
+
+// DeleteHoliday godoc
+// @Summary Delete holiday
+// @Description Delete a holiday by ID
+// @Tags Pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Holiday ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/pricing/holidays/{id} [delete]
+func (ctrl *Controller) DeleteHoliday(c *gin.Context) {
+	holidayID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid holiday ID")
+		return
+	}
+	if err := ctrl.service.DeleteHoliday(uint(holidayID)); err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "holiday deleted successfully", nil)
+}
@@ -0,0 +1,180 @@
+package pricing
+
+import (
+	"fmt"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"math"
+	"time"
+)
+
+func toHolidayResponse(h *Holiday) *HolidayResponse {
+	return &HolidayResponse{
+		ID:   h.ID,
+		Date: h.Date.Format("2006-01-02"),
+		Name: h.Name,
+	}
+}
+
+// buildQuote menghitung rincian harga untuk periode [start, end).
+// rule nil berarti tarif harian biasa dari vehicle.PricePerDay.
+func buildQuote(rule *PricingRule, vh *vehicle.Vehicle, start, end time.Time, holidays map[string]bool) *Quote {
+	if rule == nil {
+		rule = &PricingRule{}
+	}
+
+	// Tentukan satuan tagihan: per jam atau per hari.
+	// Hari tertagih mengikuti aturan clock (blok 24 jam / tanggal kalender + grace).
+	rate := rule.DailyRate
+	if rate == 0 {
+		rate = vh.PricePerDay
+	}
+	unitName := "day"
+	var periods []time.Time
+	if rule.HourlyRate > 0 {
+		rate = rule.HourlyRate
+		unitName = "hour"
+		hours := int(math.Ceil(end.Sub(start).Hours()))
+		if hours < 1 {
+			hours = 1
+		}
+		for i := 0; i < hours; i++ {
+			periods = append(periods, start.Add(time.Duration(i)*time.Hour))
+		}
+	} else {
+		periods = clock.BillingDays(start, end)
+	}
+	units := len(periods)
+
+	// Hitung berapa satuan yang jatuh di akhir pekan / hari libur (zona bisnis).
+	// Hari libur tidak dihitung dobel sebagai akhir pekan.
+	weekendUnits, holidayUnits := 0, 0
+	for _, t := range periods {
+		t = t.In(clock.Location())
+		switch {
+		case holidays[clock.Date(t)]:
+			holidayUnits++
+		case t.Weekday() == time.Saturday || t.Weekday() == time.Sunday:
+			weekendUnits++
+		}
+	}
+
+	base := float64(units) * rate
+	items := []LineItem{{
+		Type:        ChargeBase,
+		Description: fmt.Sprintf("%d %s x %.2f", units, unitName, rate),
+		Quantity:    float64(units),
+		UnitPrice:   rate,
+		Amount:      round2(base),
+	}}
+
+	if weekendUnits > 0 && rule.WeekendSurchargePercent > 0 {
+		unitPrice := rate * rule.WeekendSurchargePercent / 100
+		items = append(items, LineItem{
+			Type:        ChargeWeekendSurcharge,
+			Description: fmt.Sprintf("Weekend surcharge %.0f%%", rule.WeekendSurchargePercent),
+			Quantity:    float64(weekendUnits),
+			UnitPrice:   unitPrice,
+			Amount:      round2(float64(weekendUnits) * unitPrice),
+		})
+	}
+
+	if holidayUnits > 0 && rule.HolidaySurchargePercent > 0 {
+		unitPrice := rate * rule.HolidaySurchargePercent / 100
+		items = append(items, LineItem{
+			Type:        ChargeHolidaySurcharge,
+			Description: fmt.Sprintf("Holiday surcharge %.0f%%", rule.HolidaySurchargePercent),
+			Quantity:    float64(holidayUnits),
+			UnitPrice:   unitPrice,
+			Amount:      round2(float64(holidayUnits) * unitPrice),
+		})
+	}
+
+	// Diskon mingguan / bulanan dari base price, pilih yang paling besar berlaku
+	days := float64(units)
+	if unitName == "hour" {
+		days = end.Sub(start).Hours() / 24
+	}
+	discount := 0.0
+	if days >= 30 && rule.MonthlyDiscountPercent > 0 {
+		discount = rule.MonthlyDiscountPercent
+	} else if days >= 7 && rule.WeeklyDiscountPercent > 0 {
+		discount = rule.WeeklyDiscountPercent
+	}
+	if discount > 0 {
+		items = append(items, LineItem{
+			Type:        ChargeDiscount,
+			Description: fmt.Sprintf("Long rental discount %.0f%%", discount),
+			Quantity:    1,
+			UnitPrice:   -round2(base * discount / 100),
+			Amount:      -round2(base * discount / 100),
+		})
+	}
+
+	subtotal := sumItems(items)
+	if rule.MinimumCharge > 0 && subtotal < rule.MinimumCharge {
+		topUp := round2(rule.MinimumCharge - subtotal)
+		items = append(items, LineItem{
+			Type:        ChargeMinimum,
+			Description: fmt.Sprintf("Minimum charge %.2f", rule.MinimumCharge),
+			Quantity:    1,
+			UnitPrice:   topUp,
+			Amount:      topUp,
+		})
+	}
+
+	return &Quote{
+		Items: items,
+		Total: sumItems(items),
+	}
+}
+
+func toCancellationPolicy(req *CancellationPolicyRequest) *CancellationPolicy {
+	policy := &CancellationPolicy{
+		Name:             req.Name,
+		NoShowFeePercent: req.NoShowFeePercent,
+	}
+	if req.VehicleType != nil {
+		vt := vehicle.VehicleType(*req.VehicleType)
+		policy.VehicleType = &vt
+	}
+	for _, tier := range req.Tiers {
+		policy.Tiers = append(policy.Tiers, CancellationTier{
+			WithinHours: tier.WithinHours,
+			FeePercent:  tier.FeePercent,
+		})
+	}
+	return policy
+}
+
+// cancellationFeePercent memilih persentase biaya dari policy berdasarkan
+// sisa waktu sebelum pickup. lead <= 0 (sudah lewat pickup) memakai tier terkecil.
+func cancellationFeePercent(policy *CancellationPolicy, lead time.Duration, noShow bool) float64 {
+	if noShow {
+		return policy.NoShowFeePercent
+	}
+	percent := 0.0
+	matched := -1
+	for _, tier := range policy.Tiers {
+		if lead >= time.Duration(tier.WithinHours)*time.Hour {
+			continue
+		}
+		if matched == -1 || tier.WithinHours < matched {
+			matched = tier.WithinHours
+			percent = tier.FeePercent
+		}
+	}
+	return percent
+}
+
+func sumItems(items []LineItem) float64 {
+	total := 0.0
+	for _, item := range items {
+		total += item.Amount
+	}
+	return round2(total)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,130 @@
+package pricing
+
+import (
+	"go-rental/internal/vehicle"
+	"time"
+)
+
+type ChargeType string
+
+const (
+	ChargeBase             ChargeType = "base"
+	ChargeWeekendSurcharge ChargeType = "weekend_surcharge"
+	ChargeHolidaySurcharge ChargeType = "holiday_surcharge"
+	ChargeDiscount         ChargeType = "discount"
+	ChargeMinimum          ChargeType = "minimum_charge"
+	ChargeLateFee          ChargeType = "late_fee"
+	ChargeManual           ChargeType = "manual"
+	ChargeDamage           ChargeType = "damage"
+	ChargeCancellationFee  ChargeType = "cancellation_fee"
+	ChargeNoShowFee        ChargeType = "no_show_fee"
+	ChargeExtra            ChargeType = "extra"
+	ChargeOneWayFee        ChargeType = "one_way_fee"
+)
+
+// PricingRule berlaku untuk satu kendaraan (VehicleID) atau satu tipe
+// kendaraan (VehicleType). Rule per kendaraan lebih diprioritaskan.
+type PricingRule struct {
+	ID                      uint                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleType             *vehicle.VehicleType `json:"vehicle_type" gorm:"type:enum('car', 'bike');default:null"`
+	VehicleID               *uint                `json:"vehicle_id" gorm:"default:null;index"`
+	HourlyRate              float64              `json:"hourly_rate"`  // > 0 berarti ditagih per jam
+	DailyRate               float64              `json:"daily_rate"`   // 0 berarti pakai vehicle.PricePerDay
+	WeeklyDiscountPercent   float64              `json:"weekly_discount_percent"`
+	MonthlyDiscountPercent  float64              `json:"monthly_discount_percent"`
+	WeekendSurchargePercent float64              `json:"weekend_surcharge_percent"`
+	HolidaySurchargePercent float64              `json:"holiday_surcharge_percent"`
+	MinimumCharge           float64              `json:"minimum_charge"`
+}
+
+// CancellationPolicy menentukan biaya pembatalan per tipe kendaraan.
+// VehicleType nil = policy default untuk tipe yang tidak punya policy sendiri.
+// Biaya dihitung dari persentase proyeksi harga periode yang dibooking.
+type CancellationPolicy struct {
+	ID               uint                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	VehicleType      *vehicle.VehicleType `json:"vehicle_type" gorm:"type:enum('car', 'bike');default:null"`
+	Name             string               `json:"name"`
+	NoShowFeePercent float64              `json:"no_show_fee_percent"`
+	Tiers            []CancellationTier   `json:"tiers" gorm:"foreignKey:PolicyID"`
+}
+
+// CancellationTier: pembatalan kurang dari WithinHours sebelum pickup dikenai FeePercent.
+// Jika beberapa tier cocok, tier dengan WithinHours terkecil yang dipakai.
+type CancellationTier struct {
+	ID          uint    `json:"id" gorm:"primaryKey;autoIncrement"`
+	PolicyID    uint    `json:"policy_id" gorm:"index"`
+	WithinHours int     `json:"within_hours"`
+	FeePercent  float64 `json:"fee_percent"`
+}
+
+type Holiday struct {
+	ID   uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Date time.Time `json:"date" gorm:"type:date;uniqueIndex"`
+	Name string    `json:"name"`
+}
+
+// LineItem adalah satu baris rincian tagihan
+type LineItem struct {
+	Type        ChargeType `json:"type"`
+	Description string     `json:"description"`
+	Quantity    float64    `json:"quantity"`
+	UnitPrice   float64    `json:"unit_price"`
+	Amount      float64    `json:"amount"`
+}
+
+// Quote adalah hasil perhitungan harga beserta rinciannya
+type Quote struct {
+	Items []LineItem `json:"items"`
+	Total float64    `json:"total"`
+}
+
+type PricingRuleRequest struct {
+	VehicleType             *string `json:"vehicle_type" form:"vehicle_type" binding:"omitempty,oneof=car bike"`
+	VehicleID               *uint   `json:"vehicle_id" form:"vehicle_id" binding:"omitempty"`
+	HourlyRate              float64 `json:"hourly_rate" form:"hourly_rate" binding:"min=0"`
+	DailyRate               float64 `json:"daily_rate" form:"daily_rate" binding:"min=0"`
+	WeeklyDiscountPercent   float64 `json:"weekly_discount_percent" form:"weekly_discount_percent" binding:"min=0,max=100"`
+	MonthlyDiscountPercent  float64 `json:"monthly_discount_percent" form:"monthly_discount_percent" binding:"min=0,max=100"`
+	WeekendSurchargePercent float64 `json:"weekend_surcharge_percent" form:"weekend_surcharge_percent" binding:"min=0"`
+	HolidaySurchargePercent float64 `json:"holiday_surcharge_percent" form:"holiday_surcharge_percent" binding:"min=0"`
+	MinimumCharge           float64 `json:"minimum_charge" form:"minimum_charge" binding:"min=0"`
+}
+
+type UpdatePricingRuleRequest struct {
+	HourlyRate              *float64 `json:"hourly_rate" form:"hourly_rate" binding:"omitempty,min=0"`
+	DailyRate               *float64 `json:"daily_rate" form:"daily_rate" binding:"omitempty,min=0"`
+	WeeklyDiscountPercent   *float64 `json:"weekly_discount_percent" form:"weekly_discount_percent" binding:"omitempty,min=0,max=100"`
+	MonthlyDiscountPercent  *float64 `json:"monthly_discount_percent" form:"monthly_discount_percent" binding:"omitempty,min=0,max=100"`
+	WeekendSurchargePercent *float64 `json:"weekend_surcharge_percent" form:"weekend_surcharge_percent" binding:"omitempty,min=0"`
+	HolidaySurchargePercent *float64 `json:"holiday_surcharge_percent" form:"holiday_surcharge_percent" binding:"omitempty,min=0"`
+	MinimumCharge           *float64 `json:"minimum_charge" form:"minimum_charge" binding:"omitempty,min=0"`
+}
+
+type CancellationPolicyRequest struct {
+	VehicleType      *string                   `json:"vehicle_type" form:"vehicle_type" binding:"omitempty,oneof=car bike"`
+	Name             string                    `json:"name" form:"name" binding:"required"`
+	NoShowFeePercent float64                   `json:"no_show_fee_percent" form:"no_show_fee_percent" binding:"min=0,max=100"`
+	Tiers            []CancellationTierRequest `json:"tiers" form:"tiers" binding:"dive"`
+}
+
+type CancellationTierRequest struct {
+	WithinHours int     `json:"within_hours" binding:"required,min=1"`
+	FeePercent  float64 `json:"fee_percent" binding:"min=0,max=100"`
+}
+
+type HolidayRequest struct {
+	Date string `json:"date" form:"date" binding:"required"` // YYYY-MM-DD
+	Name string `json:"name" form:"name" binding:"required"`
+}
+
+type HolidayResponse struct {
+	ID   uint   `json:"id"`
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+type QuoteRequest struct {
+	VehicleID uint      `form:"vehicle_id" binding:"required"`
+	From      time.Time `form:"from" binding:"required"`
+	To        time.Time `form:"to"   binding:"required"`
+}
@@ -0,0 +1,201 @@
+package pricing
+
+import (
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	CreateRule(rule *PricingRule) error
+	FindRuleByID(id uint) (*PricingRule, error)
+	FindAllRules() ([]*PricingRule, error)
+	FindRuleForVehicle(vh *vehicle.Vehicle) (*PricingRule, error)
+	UpdateRule(rule *PricingRule) error
+	DeleteRule(rule *PricingRule) error
+
+	CreatePolicy(policy *CancellationPolicy) error
+	FindPolicyByID(id uint) (*CancellationPolicy, error)
+	FindAllPolicies() ([]*CancellationPolicy, error)
+	FindPolicyForVehicleType(vt vehicle.VehicleType) (*CancellationPolicy, error)
+	UpdatePolicy(policy *CancellationPolicy) error
+	DeletePolicy(policy *CancellationPolicy) error
+
+	CreateHoliday(holiday *Holiday) error
+	FindHolidayByID(id uint) (*Holiday, error)
+	FindAllHolidays() ([]*Holiday, error)
+	FindHolidaysBetween(from, to time.Time) ([]*Holiday, error)
+	DeleteHoliday(holiday *Holiday) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// CreateRule implements Repository.
+func (r *repository) CreateRule(rule *PricingRule) error {
+	return r.db.Create(rule).Error
+}
+
+// FindRuleByID implements Repository.
+func (r *repository) FindRuleByID(id uint) (*PricingRule, error) {
+	var rule PricingRule
+	if err := r.db.First(&rule, id).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FindAllRules implements Repository.
+func (r *repository) FindAllRules() ([]*PricingRule, error) {
+	var rules []*PricingRule
+	if err := r.db.Order("id asc").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// FindRuleForVehicle implements Repository.
+// Rule per kendaraan dulu, lalu rule per tipe. Nil jika tidak ada rule.
+func (r *repository) FindRuleForVehicle(vh *vehicle.Vehicle) (*PricingRule, error) {
+	var rules []*PricingRule
+	err := r.db.
+		Where("vehicle_id = ? OR (vehicle_id IS NULL AND vehicle_type = ?)", vh.ID, vh.Type).
+		Order("vehicle_id IS NULL, id desc").
+		Limit(1).
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return rules[0], nil
+}
+
+// UpdateRule implements Repository.
+func (r *repository) UpdateRule(rule *PricingRule) error {
+	return r.db.Save(rule).Error
+}
+
+// DeleteRule implements Repository.
+func (r *repository) DeleteRule(rule *PricingRule) error {
+	return r.db.Delete(rule).Error
+}
+
+// CreatePolicy implements Repository.
+func (r *repository) CreatePolicy(policy *CancellationPolicy) error {
+	return r.db.Create(policy).Error
+}
+
+// FindPolicyByID implements Repository.
+func (r *repository) FindPolicyByID(id uint) (*CancellationPolicy, error) {
+	var policy CancellationPolicy
+	if err := r.db.Preload("Tiers").First(&policy, id).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// FindAllPolicies implements Repository.
+func (r *repository) FindAllPolicies() ([]*CancellationPolicy, error) {
+	var policies []*CancellationPolicy
+	if err := r.db.Preload("Tiers").Order("id asc").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// FindPolicyForVehicleType implements Repository.
+// Policy per tipe dulu, lalu policy default. Nil jika tidak ada policy.
+func (r *repository) FindPolicyForVehicleType(vt vehicle.VehicleType) (*CancellationPolicy, error) {
+	var policies []*CancellationPolicy
+	err := r.db.Preload("Tiers").
+		Where("vehicle_type = ? OR vehicle_type IS NULL", vt).
+		Order("vehicle_type IS NULL, id desc").
+		Limit(1).
+		Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	return policies[0], nil
+}
+
+// UpdatePolicy implements Repository.
+// Tier lama diganti seluruhnya dengan policy.Tiers.
+func (r *repository) UpdatePolicy(policy *CancellationPolicy) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("policy_id = ?", policy.ID).Delete(&CancellationTier{}).Error; err != nil {
+			return err
+		}
+		for i := range policy.Tiers {
+			policy.Tiers[i].ID = 0
+			policy.Tiers[i].PolicyID = policy.ID
+		}
+		if len(policy.Tiers) > 0 {
+			if err := tx.Create(&policy.Tiers).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Omit("Tiers").Save(policy).Error
+	})
+}
+
+// DeletePolicy implements Repository.
+func (r *repository) DeletePolicy(policy *CancellationPolicy) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("policy_id = ?", policy.ID).Delete(&CancellationTier{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(policy).Error
+	})
+}
+
+// CreateHoliday implements Repository.
+func (r *repository) CreateHoliday(holiday *Holiday) error {
+	return r.db.Create(holiday).Error
+}
+
+// FindHolidayByID implements Repository.
+func (r *repository) FindHolidayByID(id uint) (*Holiday, error) {
+	var holiday Holiday
+	if err := r.db.First(&holiday, id).Error; err != nil {
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// FindAllHolidays implements Repository.
+func (r *repository) FindAllHolidays() ([]*Holiday, error) {
+	var holidays []*Holiday
+	if err := r.db.Order("date asc").Find(&holidays).Error; err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// FindHolidaysBetween implements Repository.
+func (r *repository) FindHolidaysBetween(from, to time.Time) ([]*Holiday, error) {
+	var holidays []*Holiday
+	err := r.db.
+		Where("date >= ? AND date <= ?", clock.Date(from), clock.Date(to)).
+		Find(&holidays).Error
+	if err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// DeleteHoliday implements Repository.
+func (r *repository) DeleteHoliday(holiday *Holiday) error {
+	return r.db.Delete(holiday).Error
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
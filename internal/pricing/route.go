@@ -0,0 +1,29 @@
+package pricing
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPricingRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	pricing := r.Group("/api/pricing")
+	{
+		pricing.GET("/quote", middlewares.Authenticate(cfg), ctrl.GetQuote)
+
+		pricing.POST("/rules", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateRule)
+		pricing.GET("/rules", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.GetRules)
+		pricing.PUT("/rules/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateRule)
+		pricing.DELETE("/rules/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteRule)
+
+		pricing.POST("/cancellation-policies", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateCancellationPolicy)
+		pricing.GET("/cancellation-policies", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.GetCancellationPolicies)
+		pricing.PUT("/cancellation-policies/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateCancellationPolicy)
+		pricing.DELETE("/cancellation-policies/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteCancellationPolicy)
+
+		pricing.POST("/holidays", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateHoliday)
+		pricing.GET("/holidays", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.GetHolidays)
+		pricing.DELETE("/holidays/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteHoliday)
+	}
+}
@@ -0,0 +1,295 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/config"
+	"time"
+)
+
+type Service interface {
+	// Engine
+	Calculate(vh *vehicle.Vehicle, start, end time.Time) (*Quote, error)
+	GetQuote(req *QuoteRequest) (*Quote, error)
+
+	// Rule
+	CreateRule(req *PricingRuleRequest) (*PricingRule, error)
+	GetAllRules() ([]*PricingRule, error)
+	UpdateRule(id uint, req *UpdatePricingRuleRequest) (*PricingRule, error)
+	DeleteRule(id uint) error
+
+	// Cancellation policy
+	CancellationFee(vh *vehicle.Vehicle, plannedStart, plannedEnd, at time.Time, noShow bool) (*LineItem, error)
+	CreateCancellationPolicy(req *CancellationPolicyRequest) (*CancellationPolicy, error)
+	GetAllCancellationPolicies() ([]*CancellationPolicy, error)
+	UpdateCancellationPolicy(id uint, req *CancellationPolicyRequest) (*CancellationPolicy, error)
+	DeleteCancellationPolicy(id uint) error
+
+	// Holiday
+	CreateHoliday(req *HolidayRequest) (*HolidayResponse, error)
+	GetAllHolidays() ([]*HolidayResponse, error)
+	DeleteHoliday(id uint) error
+}
+
+type service struct {
+	repo        Repository
+	vehicleRepo vehicle.Repository
+}
+
+// Calculate implements Service.
+func (s *service) Calculate(vh *vehicle.Vehicle, start, end time.Time) (*Quote, error) {
+	if end.Before(start) {
+		return nil, errors.New("end time cannot be before start time")
+	}
+
+	rule, err := s.repo.FindRuleForVehicle(vh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pricing rule: %w", err)
+	}
+
+	holidays, err := s.repo.FindHolidaysBetween(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holidays: %w", err)
+	}
+	holidaySet := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		holidaySet[h.Date.Format("2006-01-02")] = true
+	}
+
+	return buildQuote(rule, vh, start, end, holidaySet), nil
+}
+
+// GetQuote implements Service.
+func (s *service) GetQuote(req *QuoteRequest) (*Quote, error) {
+	if !req.To.After(req.From) {
+		return nil, errors.New("to must be after from")
+	}
+	vh, err := s.vehicleRepo.FindByID(req.VehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("vehicle not found: %w", err)
+	}
+	return s.Calculate(vh, req.From, req.To)
+}
+
+// CreateRule implements Service.
+func (s *service) CreateRule(req *PricingRuleRequest) (*PricingRule, error) {
+	if (req.VehicleType == nil) == (req.VehicleID == nil) {
+		return nil, errors.New("exactly one of vehicle_type or vehicle_id is required")
+	}
+
+	rule := &PricingRule{
+		VehicleID:               req.VehicleID,
+		HourlyRate:              req.HourlyRate,
+		DailyRate:               req.DailyRate,
+		WeeklyDiscountPercent:   req.WeeklyDiscountPercent,
+		MonthlyDiscountPercent:  req.MonthlyDiscountPercent,
+		WeekendSurchargePercent: req.WeekendSurchargePercent,
+		HolidaySurchargePercent: req.HolidaySurchargePercent,
+		MinimumCharge:           req.MinimumCharge,
+	}
+	if req.VehicleType != nil {
+		vt := vehicle.VehicleType(*req.VehicleType)
+		rule.VehicleType = &vt
+	}
+	if req.VehicleID != nil {
+		if _, err := s.vehicleRepo.FindByID(*req.VehicleID); err != nil {
+			return nil, fmt.Errorf("vehicle not found: %w", err)
+		}
+	}
+
+	if err := s.repo.CreateRule(rule); err != nil {
+		return nil, fmt.Errorf("failed to create pricing rule: %w", err)
+	}
+	return rule, nil
+}
+
+// GetAllRules implements Service.
+func (s *service) GetAllRules() ([]*PricingRule, error) {
+	rules, err := s.repo.FindAllRules()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pricing rules: %w", err)
+	}
+	return rules, nil
+}
+
+// UpdateRule implements Service.
+func (s *service) UpdateRule(id uint, req *UpdatePricingRuleRequest) (*PricingRule, error) {
+	rule, err := s.repo.FindRuleByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("pricing rule not found: %w", err)
+	}
+
+	// Update only fields that are not nil
+	if req.HourlyRate != nil {
+		rule.HourlyRate = *req.HourlyRate
+	}
+	if req.DailyRate != nil {
+		rule.DailyRate = *req.DailyRate
+	}
+	if req.WeeklyDiscountPercent != nil {
+		rule.WeeklyDiscountPercent = *req.WeeklyDiscountPercent
+	}
+	if req.MonthlyDiscountPercent != nil {
+		rule.MonthlyDiscountPercent = *req.MonthlyDiscountPercent
+	}
+	if req.WeekendSurchargePercent != nil {
+		rule.WeekendSurchargePercent = *req.WeekendSurchargePercent
+	}
+	if req.HolidaySurchargePercent != nil {
+		rule.HolidaySurchargePercent = *req.HolidaySurchargePercent
+	}
+	if req.MinimumCharge != nil {
+		rule.MinimumCharge = *req.MinimumCharge
+	}
+
+	if err := s.repo.UpdateRule(rule); err != nil {
+		return nil, fmt.Errorf("failed to update pricing rule: %w", err)
+	}
+	return rule, nil
+}
+
+// DeleteRule implements Service.
+func (s *service) DeleteRule(id uint) error {
+	rule, err := s.repo.FindRuleByID(id)
+	if err != nil {
+		return fmt.Errorf("pricing rule not found: %w", err)
+	}
+	if err := s.repo.DeleteRule(rule); err != nil {
+		return fmt.Errorf("failed to delete pricing rule: %w", err)
+	}
+	return nil
+}
+
+// CancellationFee implements Service.
+// Nil jika tidak ada policy untuk tipe kendaraan atau biayanya 0.
+func (s *service) CancellationFee(vh *vehicle.Vehicle, plannedStart, plannedEnd, at time.Time, noShow bool) (*LineItem, error) {
+	policy, err := s.repo.FindPolicyForVehicleType(vh.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cancellation policy: %w", err)
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	percent := cancellationFeePercent(policy, plannedStart.Sub(at), noShow)
+	if percent <= 0 {
+		return nil, nil
+	}
+
+	// Basis biaya = proyeksi harga periode yang dibooking
+	quote, err := s.Calculate(vh, plannedStart, plannedEnd)
+	if err != nil {
+		return nil, err
+	}
+	amount := round2(quote.Total * percent / 100)
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	item := &LineItem{
+		Type:        ChargeCancellationFee,
+		Description: fmt.Sprintf("Cancellation fee %.0f%% (%s)", percent, policy.Name),
+		Quantity:    1,
+		UnitPrice:   amount,
+		Amount:      amount,
+	}
+	if noShow {
+		item.Type = ChargeNoShowFee
+		item.Description = fmt.Sprintf("No-show fee %.0f%% (%s)", percent, policy.Name)
+	}
+	return item, nil
+}
+
+// CreateCancellationPolicy implements Service.
+func (s *service) CreateCancellationPolicy(req *CancellationPolicyRequest) (*CancellationPolicy, error) {
+	policy := toCancellationPolicy(req)
+	if err := s.repo.CreatePolicy(policy); err != nil {
+		return nil, fmt.Errorf("failed to create cancellation policy: %w", err)
+	}
+	return policy, nil
+}
+
+// GetAllCancellationPolicies implements Service.
+func (s *service) GetAllCancellationPolicies() ([]*CancellationPolicy, error) {
+	policies, err := s.repo.FindAllPolicies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve cancellation policies: %w", err)
+	}
+	return policies, nil
+}
+
+// UpdateCancellationPolicy implements Service.
+func (s *service) UpdateCancellationPolicy(id uint, req *CancellationPolicyRequest) (*CancellationPolicy, error) {
+	if _, err := s.repo.FindPolicyByID(id); err != nil {
+		return nil, fmt.Errorf("cancellation policy not found: %w", err)
+	}
+
+	policy := toCancellationPolicy(req)
+	policy.ID = id
+	if err := s.repo.UpdatePolicy(policy); err != nil {
+		return nil, fmt.Errorf("failed to update cancellation policy: %w", err)
+	}
+	return s.repo.FindPolicyByID(id)
+}
+
+// DeleteCancellationPolicy implements Service.
+func (s *service) DeleteCancellationPolicy(id uint) error {
+	policy, err := s.repo.FindPolicyByID(id)
+	if err != nil {
+		return fmt.Errorf("cancellation policy not found: %w", err)
+	}
+	if err := s.repo.DeletePolicy(policy); err != nil {
+		return fmt.Errorf("failed to delete cancellation policy: %w", err)
+	}
+	return nil
+}
+
+// CreateHoliday implements Service.
+func (s *service) CreateHoliday(req *HolidayRequest) (*HolidayResponse, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, errors.New("invalid date format (use YYYY-MM-DD)")
+	}
+
+	holiday := &Holiday{
+		Date: date,
+		Name: req.Name,
+	}
+	if err := s.repo.CreateHoliday(holiday); err != nil {
+		return nil, fmt.Errorf("failed to create holiday: %w", err)
+	}
+	return toHolidayResponse(holiday), nil
+}
+
+// GetAllHolidays implements Service.
+func (s *service) GetAllHolidays() ([]*HolidayResponse, error) {
+	holidays, err := s.repo.FindAllHolidays()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve holidays: %w", err)
+	}
+	var responses []*HolidayResponse
+	for _, h := range holidays {
+		responses = append(responses, toHolidayResponse(h))
+	}
+	return responses, nil
+}
+
+// DeleteHoliday implements Service.
+func (s *service) DeleteHoliday(id uint) error {
+	holiday, err := s.repo.FindHolidayByID(id)
+	if err != nil {
+		return fmt.Errorf("holiday not found: %w", err)
+	}
+	if err := s.repo.DeleteHoliday(holiday); err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+	return nil
+}
+
+func NewService(repo Repository, vehicleRepo vehicle.Repository, cfg *config.Config) Service {
+	return &service{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+	}
+}
@@ -0,0 +1,120 @@
+package promo
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(s Service) *Controller {
+	return &Controller{
+		service: s,
+	}
+}
+
+// CreatePromo godoc
+// @Summary Create promo code
+// @Description Create a percentage or fixed discount voucher with validity window, usage limits and restrictions
+// @Tags Promo
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body PromoRequest true "Promo data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/promo/ [post]
+func (ctrl *Controller) CreatePromo(c *gin.Context) {
+	var req PromoRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	promo, err := ctrl.service.CreatePromo(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "promo created successfully", promo)
+}
+
+// GetPromos godoc
+// @Summary Get promo codes
+// @Description Retrieve all promo codes with their usage count
+// @Tags Promo
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/promo/ [get]
+func (ctrl *Controller) GetPromos(c *gin.Context) {
+	promos, err := ctrl.service.GetAllPromos()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "promos retrieved successfully", promos)
+}
+
+// GetPromoByID godoc
+// @Summary Get promo code by ID
+// @Description Retrieve a promo code by its ID
+// @Tags Promo
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Promo ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/promo/{id} [get]
+func (ctrl *Controller) GetPromoByID(c *gin.Context) {
+	promoID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid promo ID")
+		return
+	}
+	promo, err := ctrl.service.GetPromoByID(uint(promoID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "promo retrieved successfully", promo)
+}
+
+// UpdatePromo godoc
+// @Summary Update promo code
+// @Description Update a promo code, including deactivating it
+// @Tags Promo
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Promo ID"
+// @Param data body UpdatePromoRequest true "Promo update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/promo/{id} [put]
+func (ctrl *Controller) UpdatePromo(c *gin.Context) {
+	promoID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid promo ID")
+		return
+	}
+	var req UpdatePromoRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	promo, err := ctrl.service.UpdatePromo(uint(promoID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "promo updated successfully", promo)
+}
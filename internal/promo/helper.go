@@ -0,0 +1,71 @@
+package promo
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/pricing"
+	"go-rental/pkg/clock"
+	"math"
+	"strings"
+	"time"
+)
+
+// validate mengecek aturan promo terhadap booking, di luar kuota pemakaian
+func validate(p *Promo, in *RedeemInput) error {
+	if !p.Active {
+		return errors.New("promo code is not active")
+	}
+	if in.Start.Before(p.ValidFrom) || in.Start.After(p.ValidUntil) {
+		return errors.New("promo code is not valid for the rental date")
+	}
+	if p.VehicleTypes != "" && !containsType(p.VehicleTypes, in.VehicleType) {
+		return fmt.Errorf("promo code is not valid for vehicle type %s", in.VehicleType)
+	}
+	if p.MinRentalDays > 0 && rentalDays(in.Start, in.End) < p.MinRentalDays {
+		return fmt.Errorf("promo code requires a minimum rental of %d days", p.MinRentalDays)
+	}
+	return nil
+}
+
+// calculateDiscount menghitung potongan untuk subtotal sewa, tidak pernah melebihi subtotal
+func calculateDiscount(p *Promo, subtotal float64) float64 {
+	discount := p.DiscountValue
+	if p.DiscountType == DiscountPercent {
+		discount = subtotal * p.DiscountValue / 100
+		if p.MaxDiscount > 0 && discount > p.MaxDiscount {
+			discount = p.MaxDiscount
+		}
+	}
+	if discount > subtotal {
+		discount = subtotal
+	}
+	return math.Round(discount*100) / 100
+}
+
+// discountLine membuat baris tagihan diskon, nil jika tidak ada potongan
+func discountLine(p *Promo, amount float64) *pricing.LineItem {
+	if amount <= 0 {
+		return nil
+	}
+	return &pricing.LineItem{
+		Type:        pricing.ChargeDiscount,
+		Description: "Promo " + p.Code,
+		Quantity:    1,
+		UnitPrice:   -amount,
+		Amount:      -amount,
+	}
+}
+
+// rentalDays mengikuti aturan hari tertagih sewa (clock.BillingDays)
+func rentalDays(start, end time.Time) int {
+	return len(clock.BillingDays(start, end))
+}
+
+func containsType(types string, vehicleType string) bool {
+	for _, t := range strings.Split(types, ",") {
+		if strings.TrimSpace(t) == vehicleType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package promo
+
+import (
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "percent"
+	DiscountFixed   DiscountType = "fixed"
+)
+
+// Promo adalah kode voucher diskon. Limit 0 berarti tanpa batas.
+type Promo struct {
+	ID               uint         `json:"id" gorm:"primaryKey;autoIncrement"`
+	Code             string       `json:"code" gorm:"type:varchar(50);uniqueIndex"`
+	Description      string       `json:"description"`
+	DiscountType     DiscountType `json:"discount_type" gorm:"type:enum('percent', 'fixed')"`
+	DiscountValue    float64      `json:"discount_value"`
+	MaxDiscount      float64      `json:"max_discount"` // batas atas diskon persen, 0 = tanpa batas
+	ValidFrom        time.Time    `json:"valid_from"`
+	ValidUntil       time.Time    `json:"valid_until"`
+	UsageLimit       int          `json:"usage_limit"`
+	PerCustomerLimit int          `json:"per_customer_limit"`
+	UsedCount        int          `json:"used_count"`
+	MinRentalDays    int          `json:"min_rental_days"`
+	VehicleTypes     string       `json:"vehicle_types"` // dipisah koma, kosong = semua tipe
+	Active           bool         `json:"active" gorm:"default:true"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+// Redemption mencatat pemakaian promo oleh satu rent.
+// Dibuat saat rent dibuat, Amount diisi saat harga final dihitung,
+// dan dihapus jika rent dibatalkan supaya kuota kembali.
+type Redemption struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	PromoID    uint      `json:"promo_id" gorm:"index"`
+	RentID     uint      `json:"rent_id" gorm:"uniqueIndex"`
+	CustomerID uint      `json:"customer_id" gorm:"index"`
+	Amount     float64   `json:"amount"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (Redemption) TableName() string {
+	return "promo_redemptions"
+}
+
+// RedeemInput adalah data booking yang divalidasi terhadap aturan promo
+type RedeemInput struct {
+	Code        string
+	RentID      uint
+	CustomerID  uint
+	VehicleType string
+	Start       time.Time
+	End         time.Time
+}
+
+type PromoRequest struct {
+	Code             string    `json:"code" form:"code" binding:"required"`
+	Description      string    `json:"description" form:"description"`
+	DiscountType     string    `json:"discount_type" form:"discount_type" binding:"required,oneof=percent fixed"`
+	DiscountValue    float64   `json:"discount_value" form:"discount_value" binding:"required,gt=0"`
+	MaxDiscount      float64   `json:"max_discount" form:"max_discount" binding:"min=0"`
+	ValidFrom        time.Time `json:"valid_from" form:"valid_from" binding:"required"`
+	ValidUntil       time.Time `json:"valid_until" form:"valid_until" binding:"required"`
+	UsageLimit       int       `json:"usage_limit" form:"usage_limit" binding:"min=0"`
+	PerCustomerLimit int       `json:"per_customer_limit" form:"per_customer_limit" binding:"min=0"`
+	MinRentalDays    int       `json:"min_rental_days" form:"min_rental_days" binding:"min=0"`
+	VehicleTypes     []string  `json:"vehicle_types" form:"vehicle_types" binding:"dive,oneof=car bike"`
+}
+
+type UpdatePromoRequest struct {
+	Description      *string    `json:"description" form:"description" binding:"omitempty"`
+	DiscountValue    *float64   `json:"discount_value" form:"discount_value" binding:"omitempty,gt=0"`
+	MaxDiscount      *float64   `json:"max_discount" form:"max_discount" binding:"omitempty,min=0"`
+	ValidFrom        *time.Time `json:"valid_from" form:"valid_from" binding:"omitempty"`
+	ValidUntil       *time.Time `json:"valid_until" form:"valid_until" binding:"omitempty"`
+	UsageLimit       *int       `json:"usage_limit" form:"usage_limit" binding:"omitempty,min=0"`
+	PerCustomerLimit *int       `json:"per_customer_limit" form:"per_customer_limit" binding:"omitempty,min=0"`
+	MinRentalDays    *int       `json:"min_rental_days" form:"min_rental_days" binding:"omitempty,min=0"`
+	VehicleTypes     *[]string  `json:"vehicle_types" form:"vehicle_types" binding:"omitempty,dive,oneof=car bike"`
+	Active           *bool      `json:"active" form:"active" binding:"omitempty"`
+}
@@ -0,0 +1,119 @@
+package promo
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Create(promo *Promo) error
+	FindByID(id uint) (*Promo, error)
+	FindByIDForUpdate(id uint) (*Promo, error)
+	FindByCodeForUpdate(code string) (*Promo, error)
+	FindAll() ([]*Promo, error)
+	Update(promo *Promo) error
+
+	CreateRedemption(redemption *Redemption) error
+	FindRedemptionByRentID(rentID uint) (*Redemption, error)
+	CountCustomerRedemptions(promoID, customerID uint) (int64, error)
+	UpdateRedemption(redemption *Redemption) error
+	DeleteRedemption(redemption *Redemption) error
+
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(promo *Promo) error {
+	return r.db.Create(promo).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Promo, error) {
+	var promo Promo
+	if err := r.db.First(&promo, id).Error; err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+// FindByIDForUpdate implements Repository.
+func (r *repository) FindByIDForUpdate(id uint) (*Promo, error) {
+	var promo Promo
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promo, id).Error; err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+// FindByCodeForUpdate implements Repository.
+// Mengunci baris promo supaya pengecekan kuota dan penambahan UsedCount
+// tidak bisa disalip redeem paralel. Hanya berguna di dalam transaksi.
+func (r *repository) FindByCodeForUpdate(code string) (*Promo, error) {
+	var promo Promo
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&promo).Error; err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll() ([]*Promo, error) {
+	var promos []*Promo
+	if err := r.db.Order("id desc").Find(&promos).Error; err != nil {
+		return nil, err
+	}
+	return promos, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(promo *Promo) error {
+	return r.db.Save(promo).Error
+}
+
+// CreateRedemption implements Repository.
+func (r *repository) CreateRedemption(redemption *Redemption) error {
+	return r.db.Create(redemption).Error
+}
+
+// FindRedemptionByRentID implements Repository.
+func (r *repository) FindRedemptionByRentID(rentID uint) (*Redemption, error) {
+	var redemption Redemption
+	if err := r.db.Where("rent_id = ?", rentID).First(&redemption).Error; err != nil {
+		return nil, err
+	}
+	return &redemption, nil
+}
+
+// CountCustomerRedemptions implements Repository.
+func (r *repository) CountCustomerRedemptions(promoID, customerID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&Redemption{}).
+		Where("promo_id = ? AND customer_id = ?", promoID, customerID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateRedemption implements Repository.
+func (r *repository) UpdateRedemption(redemption *Redemption) error {
+	return r.db.Save(redemption).Error
+}
+
+// DeleteRedemption implements Repository.
+func (r *repository) DeleteRedemption(redemption *Redemption) error {
+	return r.db.Delete(redemption).Error
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,18 @@
+package promo
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupPromoRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	promo := r.Group("/api/promo")
+	{
+		promo.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreatePromo)
+		promo.GET("/", middlewares.Authenticate(cfg), ctrl.GetPromos)
+		promo.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetPromoByID)
+		promo.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdatePromo)
+	}
+}
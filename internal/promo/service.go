@@ -0,0 +1,252 @@
+package promo
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/pricing"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreatePromo(req *PromoRequest) (*Promo, error)
+	GetAllPromos() ([]*Promo, error)
+	GetPromoByID(id uint) (*Promo, error)
+	UpdatePromo(id uint, req *UpdatePromoRequest) (*Promo, error)
+
+	// Dipanggil dari transaksi rent
+	Redeem(tx *gorm.DB, in *RedeemInput) (*Promo, error)
+	Apply(tx *gorm.DB, rentID uint, subtotal float64) (*pricing.LineItem, error)
+	Estimate(tx *gorm.DB, rentID uint, subtotal float64) (*pricing.LineItem, error)
+	Release(tx *gorm.DB, rentID uint) error
+}
+
+type service struct {
+	repo Repository
+}
+
+// CreatePromo implements Service.
+func (s *service) CreatePromo(req *PromoRequest) (*Promo, error) {
+	if !req.ValidUntil.After(req.ValidFrom) {
+		return nil, errors.New("valid_until must be after valid_from")
+	}
+	if req.DiscountType == string(DiscountPercent) && req.DiscountValue > 100 {
+		return nil, errors.New("percent discount cannot exceed 100")
+	}
+
+	promo := &Promo{
+		Code:             normalizeCode(req.Code),
+		Description:      req.Description,
+		DiscountType:     DiscountType(req.DiscountType),
+		DiscountValue:    req.DiscountValue,
+		MaxDiscount:      req.MaxDiscount,
+		ValidFrom:        req.ValidFrom,
+		ValidUntil:       req.ValidUntil,
+		UsageLimit:       req.UsageLimit,
+		PerCustomerLimit: req.PerCustomerLimit,
+		MinRentalDays:    req.MinRentalDays,
+		VehicleTypes:     strings.Join(req.VehicleTypes, ","),
+		Active:           true,
+	}
+	if err := s.repo.Create(promo); err != nil {
+		return nil, fmt.Errorf("failed to create promo: %w", err)
+	}
+	return promo, nil
+}
+
+// GetAllPromos implements Service.
+func (s *service) GetAllPromos() ([]*Promo, error) {
+	promos, err := s.repo.FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve promos: %w", err)
+	}
+	return promos, nil
+}
+
+// GetPromoByID implements Service.
+func (s *service) GetPromoByID(id uint) (*Promo, error) {
+	promo, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("promo not found: %w", err)
+	}
+	return promo, nil
+}
+
+// UpdatePromo implements Service.
+func (s *service) UpdatePromo(id uint, req *UpdatePromoRequest) (*Promo, error) {
+	promo, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("promo not found: %w", err)
+	}
+
+	// Update only fields that are not nil
+	if req.Description != nil {
+		promo.Description = *req.Description
+	}
+	if req.DiscountValue != nil {
+		promo.DiscountValue = *req.DiscountValue
+	}
+	if req.MaxDiscount != nil {
+		promo.MaxDiscount = *req.MaxDiscount
+	}
+	if req.ValidFrom != nil {
+		promo.ValidFrom = *req.ValidFrom
+	}
+	if req.ValidUntil != nil {
+		promo.ValidUntil = *req.ValidUntil
+	}
+	if req.UsageLimit != nil {
+		promo.UsageLimit = *req.UsageLimit
+	}
+	if req.PerCustomerLimit != nil {
+		promo.PerCustomerLimit = *req.PerCustomerLimit
+	}
+	if req.MinRentalDays != nil {
+		promo.MinRentalDays = *req.MinRentalDays
+	}
+	if req.VehicleTypes != nil {
+		promo.VehicleTypes = strings.Join(*req.VehicleTypes, ",")
+	}
+	if req.Active != nil {
+		promo.Active = *req.Active
+	}
+
+	if !promo.ValidUntil.After(promo.ValidFrom) {
+		return nil, errors.New("valid_until must be after valid_from")
+	}
+	if promo.DiscountType == DiscountPercent && promo.DiscountValue > 100 {
+		return nil, errors.New("percent discount cannot exceed 100")
+	}
+
+	if err := s.repo.Update(promo); err != nil {
+		return nil, fmt.Errorf("failed to update promo: %w", err)
+	}
+	return promo, nil
+}
+
+// Redeem implements Service.
+// Baris promo dikunci selama transaksi rent, sehingga dua booking paralel
+// tidak bisa sama-sama lolos pengecekan kuota terakhir.
+func (s *service) Redeem(tx *gorm.DB, in *RedeemInput) (*Promo, error) {
+	repo := s.repo.WithTx(tx)
+
+	promo, err := repo.FindByCodeForUpdate(normalizeCode(in.Code))
+	if err != nil {
+		return nil, errors.New("promo code not found")
+	}
+	if err := validate(promo, in); err != nil {
+		return nil, err
+	}
+
+	if promo.UsageLimit > 0 && promo.UsedCount >= promo.UsageLimit {
+		return nil, errors.New("promo code usage limit reached")
+	}
+	if promo.PerCustomerLimit > 0 {
+		used, err := repo.CountCustomerRedemptions(promo.ID, in.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		if used >= int64(promo.PerCustomerLimit) {
+			return nil, errors.New("promo code usage limit per customer reached")
+		}
+	}
+
+	redemption := &Redemption{
+		PromoID:    promo.ID,
+		RentID:     in.RentID,
+		CustomerID: in.CustomerID,
+	}
+	if err := repo.CreateRedemption(redemption); err != nil {
+		return nil, errors.New("failed to redeem promo code")
+	}
+	promo.UsedCount++
+	if err := repo.Update(promo); err != nil {
+		return nil, errors.New("failed to redeem promo code")
+	}
+	return promo, nil
+}
+
+// Apply implements Service.
+// Menghitung diskon untuk subtotal sewa dan mencatatnya di redemption.
+// Nil jika rent tidak memakai promo.
+func (s *service) Apply(tx *gorm.DB, rentID uint, subtotal float64) (*pricing.LineItem, error) {
+	repo := s.repo.WithTx(tx)
+
+	redemption, err := repo.FindRedemptionByRentID(rentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	promo, err := repo.FindByID(redemption.PromoID)
+	if err != nil {
+		return nil, fmt.Errorf("promo not found: %w", err)
+	}
+
+	redemption.Amount = calculateDiscount(promo, subtotal)
+	if err := repo.UpdateRedemption(redemption); err != nil {
+		return nil, errors.New("failed to update promo redemption")
+	}
+	return discountLine(promo, redemption.Amount), nil
+}
+
+// Estimate implements Service.
+// Sama seperti Apply tapi tanpa mencatat redemption, untuk proyeksi harga
+// sebelum rent di-complete.
+func (s *service) Estimate(tx *gorm.DB, rentID uint, subtotal float64) (*pricing.LineItem, error) {
+	repo := s.repo.WithTx(tx)
+
+	redemption, err := repo.FindRedemptionByRentID(rentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	promo, err := repo.FindByID(redemption.PromoID)
+	if err != nil {
+		return nil, fmt.Errorf("promo not found: %w", err)
+	}
+	return discountLine(promo, calculateDiscount(promo, subtotal)), nil
+}
+
+// Release implements Service.
+// Mengembalikan kuota promo milik rent yang dibatalkan.
+func (s *service) Release(tx *gorm.DB, rentID uint) error {
+	repo := s.repo.WithTx(tx)
+
+	redemption, err := repo.FindRedemptionByRentID(rentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	promo, err := repo.FindByIDForUpdate(redemption.PromoID)
+	if err != nil {
+		return fmt.Errorf("promo not found: %w", err)
+	}
+
+	if err := repo.DeleteRedemption(redemption); err != nil {
+		return errors.New("failed to release promo redemption")
+	}
+	if promo.UsedCount > 0 {
+		promo.UsedCount--
+	}
+	if err := repo.Update(promo); err != nil {
+		return errors.New("failed to release promo redemption")
+	}
+	return nil
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo: repo,
+	}
+}
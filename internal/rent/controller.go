@@ -26,7 +26,7 @@ func NewController(rentService Service, vehicleService vehicle.Service, customer
 
 // CreateRent godoc
 // @Summary Create rent
-// @Description Create a new rent transaction
+// @Description Create a new rent transaction for a specific vehicle (vehicle_id) or a reservation by vehicle class (class_id)
 // @Tags Rent
 // @Accept json
 // @Produce json
@@ -59,11 +59,13 @@ func (ctrl *Controller) CreateRent(c *gin.Context) {
 		return
 	}
 
-	// Validate vehicle exists
-	_, err = ctrl.vehicleService.GetVehicleByID(req.VehicleID)
-	if err != nil {
-		response.Error(c, http.StatusBadRequest, "vehicle not found")
-		return
+	// Validate vehicle exists, reservasi per class dicek di service
+	if req.VehicleID != nil {
+		_, err = ctrl.vehicleService.GetVehicleByID(*req.VehicleID)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "vehicle not found")
+			return
+		}
 	}
 
 	// Call service to create rent
@@ -79,22 +81,66 @@ func (ctrl *Controller) CreateRent(c *gin.Context) {
 
 // GetRents godoc
 // @Summary Get all rents
-// @Description Retrieve all rent transactions
+// @Description Retrieve rent transactions with filters, sorting and cursor pagination
 // @Tags Rent
 // @Produce json
 // @Security BearerAuth
+// @Param status query string false "Rent status"
+// @Param customer_id query int false "Customer ID"
+// @Param vehicle_id query int false "Vehicle ID"
+// @Param class_id query int false "Vehicle class ID"
+// @Param created_by_id query int false "Staff user ID who created the rent"
+// @Param rent_from query string false "Rent date from (RFC3339)"
+// @Param rent_to query string false "Rent date to (RFC3339)"
+// @Param return_from query string false "Return date from (RFC3339)"
+// @Param return_to query string false "Return date to (RFC3339)"
+// @Param min_total query number false "Minimum total price"
+// @Param max_total query number false "Maximum total price"
+// @Param sort query string false "id, rent_date, planned_start_date, total_price or updated_at (default: id)"
+// @Param order query string false "asc or desc (default: desc)"
+// @Param limit query int false "Page size, max 100 (default: 20)"
+// @Param cursor query string false "next_cursor from the previous page"
 // @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/rent/ [get]
 func (ctrl *Controller) GetRents(c *gin.Context) {
-	rents, err := ctrl.rentService.GetAllRents()
+	var filter RentFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	rents, err := ctrl.rentService.GetAllRents(&filter)
 	if err != nil {
+		if err.Error() == "invalid cursor" {
+			response.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 	response.Success(c, http.StatusOK, "rents retrieved successfully", rents)
 }
 
+// GetOverdueRents godoc
+// @Summary Get overdue rents
+// @Description Retrieve ongoing rents whose expected return time plus grace period has passed
+// @Tags Rent
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/rent/overdue [get]
+func (ctrl *Controller) GetOverdueRents(c *gin.Context) {
+	rents, err := ctrl.rentService.GetOverdueRents()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "overdue rents retrieved successfully", rents)
+}
+
 // GetRentByID godoc
 // @Summary Get rent by ID
 // @Description Retrieve a rent transaction by its ID
@@ -123,7 +169,7 @@ func (ctrl *Controller) GetRentByID(c *gin.Context) {
 
 // UpdateRent godoc
 // @Summary Update rent
-// @Description Update rent transaction by ID
+// @Description Update rent notes by ID; status changes use the transition endpoints
 // @Tags Rent
 // @Accept json
 // @Produce json
@@ -167,3 +213,302 @@ func (ctrl *Controller) UpdateRent(c *gin.Context) {
     // Success
     response.Success(c, http.StatusOK, "rent updated successfully", updatedRent)
 }
+
+// PickupRent godoc
+// @Summary Pickup reserved rent
+// @Description Convert a reservation into an ongoing rent when the customer picks up the vehicle; class reservations get a vehicle assigned here
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body TransitionRequest true "Transition reason"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/pickup [post]
+func (ctrl *Controller) PickupRent(c *gin.Context) {
+	ctrl.transition(c, ctrl.rentService.PickupRent, "rent picked up successfully")
+}
+
+// CompleteRent godoc
+// @Summary Complete rent
+// @Description Return the vehicle, calculate the final bill and issue the invoice
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body CompleteRentRequest true "Transition reason and return branch"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/complete [post]
+func (ctrl *Controller) CompleteRent(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req CompleteRentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rent, err := ctrl.rentService.CompleteRent(uint(rentID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "rent completed successfully", rent)
+}
+
+// CancelRent godoc
+// @Summary Cancel rent
+// @Description Cancel a reserved rent. Picked-up (ongoing) rents cannot be cancelled and must be completed
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body TransitionRequest true "Transition reason"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/cancel [post]
+func (ctrl *Controller) CancelRent(c *gin.Context) {
+	ctrl.transition(c, ctrl.rentService.CancelRent, "rent cancelled successfully")
+}
+
+// NoShowRent godoc
+// @Summary Mark rent as no-show
+// @Description Mark a reservation whose customer never picked up the vehicle
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body TransitionRequest true "Transition reason"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/no-show [post]
+func (ctrl *Controller) NoShowRent(c *gin.Context) {
+	ctrl.transition(c, ctrl.rentService.NoShowRent, "rent marked as no-show successfully")
+}
+
+// CloseRent godoc
+// @Summary Close rent
+// @Description Close a completed rent once its balance is fully paid
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body TransitionRequest true "Transition reason"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/close [post]
+func (ctrl *Controller) CloseRent(c *gin.Context) {
+	ctrl.transition(c, ctrl.rentService.CloseRent, "rent closed successfully")
+}
+
+// transition dipakai bersama oleh semua endpoint transisi status
+func (ctrl *Controller) transition(c *gin.Context, fn func(uint, *TransitionRequest, uint) (*RentResponse, error), message string) {
+	idParam := c.Param("id")
+	rentID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req TransitionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rent, err := fn(uint(rentID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, message, rent)
+}
+
+// ExtendRent godoc
+// @Summary Extend rent
+// @Description Push the expected return date of a rent after checking for conflicting bookings
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body ExtendRentRequest true "New expected return"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/extend [post]
+func (ctrl *Controller) ExtendRent(c *gin.Context) {
+	idParam := c.Param("id")
+	rentID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req ExtendRentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	extended, err := ctrl.rentService.ExtendRent(uint(rentID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "rent extended successfully", extended)
+}
+
+// AddDriver godoc
+// @Summary Add additional driver
+// @Description Authorise an existing customer (customer_id) or a new customer (customer) to drive the rented vehicle; the ID card is validated
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body DriverRequest true "Driver data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/drivers [post]
+func (ctrl *Controller) AddDriver(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req DriverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rent, err := ctrl.rentService.AddDriver(uint(rentID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "driver added successfully", rent)
+}
+
+// RemoveDriver godoc
+// @Summary Remove additional driver
+// @Description Remove an additional driver from a rent
+// @Tags Rent
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param customer_id path int true "Driver customer ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/drivers/{customer_id} [delete]
+func (ctrl *Controller) RemoveDriver(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+	customerID, err := strconv.ParseUint(c.Param("customer_id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rent, err := ctrl.rentService.RemoveDriver(uint(rentID), uint(customerID), userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "driver removed successfully", rent)
+}
+
+// SwapVehicle godoc
+// @Summary Swap rent vehicle
+// @Description Replace the vehicle of an ongoing rent; a checkin of the old vehicle and a checkout of the new vehicle are recorded together. The old vehicle goes to maintenance by default and the final price is prorated per vehicle
+// @Tags Rent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Rent ID"
+// @Param data body SwapRentRequest true "Swap data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/rent/{id}/swap [post]
+func (ctrl *Controller) SwapVehicle(c *gin.Context) {
+	rentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid rent ID")
+		return
+	}
+
+	var req SwapRentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rent, err := ctrl.rentService.SwapVehicle(uint(rentID), &req, userID.(uint))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "rent vehicle swapped successfully", rent)
+}
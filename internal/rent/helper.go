@@ -1,30 +1,242 @@
 package rent
 
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"go-rental/internal/customer"
+	"go-rental/internal/inspection"
+	"go-rental/internal/pricing"
+	"go-rental/internal/vehicle"
+	"go-rental/pkg/clock"
+	"go-rental/internal/vehicleclass"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
 func ToRentResponse(rent *Rent) *RentResponse {
-	rentDate := ""
-	if !rent.RentDate.IsZero() {
-		rentDate = rent.RentDate.Format("2006-01-02 15:04:05")
+	promoCode := ""
+	if rent.Promo != nil {
+		promoCode = rent.Promo.Code
+	}
+
+	drivers := []customer.Customer{}
+	for _, d := range rent.Drivers {
+		drivers = append(drivers, d.Customer)
 	}
 
-	returnDate := ""
-	if rent.ReturnDate != nil && !rent.ReturnDate.IsZero() {
-		returnDate = rent.ReturnDate.Format("2006-01-02 15:04:05")
+	// Reservasi class belum punya kendaraan sampai pickup
+	var vh *vehicle.Vehicle
+	if rent.VehicleID != nil {
+		vh = &rent.Vehicle
 	}
 
 	return &RentResponse{
 		ID:          rent.ID,
+		BookingID:   rent.BookingID,
 		Customer:    rent.Customer,
-		Vehicle:     rent.Vehicle,
-		RentDate:    rentDate,
-		ReturnDate:  returnDate,
+		Vehicle:     vh,
+		Class:       rent.Class,
+		RentDate:    clock.Format(rent.RentDate),
+		ReturnDate:  clock.FormatPtr(rent.ReturnDate),
+		PlannedStartDate: clock.Format(rent.PlannedStartDate),
+		PlannedEndDate:   clock.FormatPtr(rent.PlannedEndDate),
 		TotalPrice:  rent.TotalPrice,
+		Charges:     rent.Charges,
+		PromoCode:   promoCode,
+		PickupBranch:  rent.PickupBranch,
+		DropoffBranch: rent.DropoffBranch,
+		ReturnBranch:  rent.ReturnBranch,
+		OneWayFee:     rent.OneWayFee,
+		Extras:      rent.Extras,
+		Drivers:     drivers,
+		Segments:    rent.Segments,
+		Inspections: inspection.CompareAll(rent.Inspections),
 		Status:      rent.Status,
+		History:     rent.History,
 		Notes:       rent.Notes,
 		CreatedBy:   rent.CreatedBy,
 		UpdatedBy:   rent.UpdatedBy,
+		UpdatedAt:   clock.Format(rent.UpdatedAt),
+	}
+}
+
+// toRentCharges mengubah rincian quote dari pricing engine menjadi RentCharge
+func toRentCharges(rentID uint, quote *pricing.Quote) []*RentCharge {
+	charges := make([]*RentCharge, 0, len(quote.Items))
+	for i := range quote.Items {
+		charges = append(charges, toRentCharge(rentID, &quote.Items[i]))
 	}
+	return charges
 }
 
+func toRentCharge(rentID uint, item *pricing.LineItem) *RentCharge {
+	return &RentCharge{
+		RentID:      rentID,
+		Type:        item.Type,
+		Description: item.Description,
+		Quantity:    item.Quantity,
+		UnitPrice:   item.UnitPrice,
+		Amount:      item.Amount,
+	}
+}
+
+// prorateItems mengambil porsi share (0..1) dari rincian harga satu kendaraan,
+// dipakai saat rent pernah tukar kendaraan
+func prorateItems(items []pricing.LineItem, share float64, plateNumber string) []pricing.LineItem {
+	prorated := make([]pricing.LineItem, 0, len(items))
+	for _, item := range items {
+		prorated = append(prorated, pricing.LineItem{
+			Type:        item.Type,
+			Description: fmt.Sprintf("[%s %.0f%%] %s", plateNumber, share*100, item.Description),
+			Quantity:    math.Round(item.Quantity*share*100) / 100,
+			UnitPrice:   item.UnitPrice,
+			Amount:      math.Round(item.Amount*share*100) / 100,
+		})
+	}
+	return prorated
+}
+
+// classVehicle adalah kendaraan pengganti untuk menghitung harga reservasi
+// class yang belum di-assign, memakai tipe dan tarif acuan class
+func classVehicle(class *vehicleclass.Class) *vehicle.Vehicle {
+	return &vehicle.Vehicle{
+		Type:        class.Type,
+		PricePerDay: class.PricePerDay,
+	}
+}
+
+// usageWindow adalah periode satu kendaraan class terpakai (rent atau servis).
+// End nil berarti tanpa batas akhir.
+type usageWindow struct {
+	Start time.Time
+	End   *time.Time
+}
+
+// peakUsage menghitung jumlah window yang berjalan bersamaan paling banyak
+// di dalam [from, to). Window bersifat setengah terbuka, jadi window yang
+// selesai tepat saat window lain mulai tidak dihitung bersamaan.
+func peakUsage(windows []usageWindow, from, to time.Time) int {
+	type event struct {
+		at    time.Time
+		delta int
+	}
+	events := make([]event, 0, len(windows)*2)
+	for _, w := range windows {
+		start, end := w.Start, to
+		if w.End != nil && w.End.Before(to) {
+			end = *w.End
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if !end.After(start) {
+			continue
+		}
+		events = append(events, event{start, 1}, event{end, -1})
+	}
+	// Selesai (-1) diproses sebelum mulai (+1) pada waktu yang sama
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].at.Equal(events[j].at) {
+			return events[i].delta < events[j].delta
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	current, peak := 0, 0
+	for _, e := range events {
+		current += e.delta
+		if current > peak {
+			peak = current
+		}
+	}
+	return peak
+}
+
+// isOverdue: rent masih ongoing padahal expected return + grace period sudah lewat
+func isOverdue(rent *Rent, now time.Time, grace time.Duration) bool {
+	if rent.Status != StatusOngoing || rent.PlannedEndDate == nil {
+		return false
+	}
+	return now.After(rent.PlannedEndDate.Add(grace))
+}
+
+// calculateLateFee menghitung denda jika ReturnDate melewati expected return
+// + grace period. Keterlambatan dihitung dari expected return dengan aturan
+// hari tertagih yang sama seperti sewa (clock.BillingDays). Nil jika tidak terlambat.
+func calculateLateFee(rent *Rent, vh *vehicle.Vehicle, grace time.Duration, percent float64) *RentCharge {
+	if rent.PlannedEndDate == nil || rent.ReturnDate == nil || percent <= 0 {
+		return nil
+	}
+	if !rent.ReturnDate.After(rent.PlannedEndDate.Add(grace)) {
+		return nil
+	}
+
+	lateDays := float64(len(clock.BillingDays(*rent.PlannedEndDate, *rent.ReturnDate)))
+	unitPrice := math.Round(vh.PricePerDay*percent) / 100
+	return &RentCharge{
+		RentID:      rent.ID,
+		Type:        pricing.ChargeLateFee,
+		Description: fmt.Sprintf("Late return %.0f day x %.0f%% daily rate", lateDays, percent),
+		Quantity:    lateDays,
+		UnitPrice:   unitPrice,
+		Amount:      math.Round(lateDays*unitPrice*100) / 100,
+	}
+}
+
+// encodeCursor membuat cursor opaque "<nilai sort>|<id>" dari baris terakhir halaman
+func encodeCursor(rent *Rent, sort string) string {
+	var value string
+	switch sort {
+	case "rent_date":
+		value = rent.RentDate.UTC().Format(time.RFC3339Nano)
+	case "planned_start_date":
+		value = rent.PlannedStartDate.UTC().Format(time.RFC3339Nano)
+	case "updated_at":
+		value = rent.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case "total_price":
+		value = strconv.FormatFloat(rent.TotalPrice, 'f', -1, 64)
+	}
+	raw := value + "|" + strconv.FormatUint(uint64(rent.ID), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor kebalikan encodeCursor, Value di-parse sesuai tipe kolom sort
+func decodeCursor(cursor string, sort string) (*PageCursor, error) {
+	errInvalid := errors.New("invalid cursor")
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalid
+	}
+	value, idPart, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errInvalid
+	}
+	id, err := strconv.ParseUint(idPart, 10, 64)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	after := &PageCursor{ID: uint(id)}
+	switch sort {
+	case "rent_date", "planned_start_date", "updated_at":
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, errInvalid
+		}
+		after.Value = t
+	case "total_price":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errInvalid
+		}
+		after.Value = f
+	}
+	return after, nil
+}
 
 // func calculateRentDays(start string, end string) (int, error) {
 // 	layout := "2006-01-02" // format: YYYY-MM-DD
@@ -1,62 +1,322 @@
 package rent
 
 import (
+	"encoding/json"
+	"go-rental/internal/branch"
 	"go-rental/internal/customer"
+	"go-rental/internal/extra"
+	"go-rental/internal/inspection"
+	"go-rental/internal/pricing"
+	"go-rental/internal/promo"
 	"go-rental/internal/user"
 	"go-rental/internal/vehicle"
+	"go-rental/internal/vehicleclass"
+	"go-rental/pkg/clock"
 	"time"
 )
 
 type RentStatus string
 
+// Lifecycle rent:
+//   reserved  -> ongoing (pickup), cancelled, no_show
+//   ongoing   -> completed (return)
+//   completed -> closed (tagihan sudah lunas)
+// ongoing = kendaraan sudah di-pickup, completed = kendaraan sudah dikembalikan.
 const (
+	StatusReserved  RentStatus = "reserved"
 	StatusOngoing   RentStatus = "ongoing"
 	StatusCompleted RentStatus = "completed"
+	StatusClosed    RentStatus = "closed"
 	StatusCancelled RentStatus = "cancelled"
+	StatusNoShow    RentStatus = "no_show"
 )
 
+// transitions adalah tabel perpindahan status yang diizinkan.
+// Rent yang sudah di-pickup tidak bisa di-cancel, harus di-complete supaya
+// pemakaian kendaraan tetap ditagih.
+var transitions = map[RentStatus][]RentStatus{
+	StatusReserved:  {StatusOngoing, StatusCancelled, StatusNoShow},
+	StatusOngoing:   {StatusCompleted},
+	StatusCompleted: {StatusClosed},
+}
+
+// CanTransitionTo mengecek apakah perpindahan status ada di tabel transitions
+func (s RentStatus) CanTransitionTo(to RentStatus) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
 type Rent struct {
 	ID          uint        `json:"id" gorm:"primaryKey;autoIncrement"`
 	CustomerID  uint        `json:"customer_id"`
-	VehicleID   uint        `json:"vehicle_id"`
+	// Reservasi per class belum punya kendaraan (VehicleID nil) sampai pickup.
+	// ClassID juga diisi untuk kendaraan yang tergabung dalam class.
+	VehicleID   *uint       `json:"vehicle_id" gorm:"default:null"`
+	ClassID     *uint       `json:"class_id" gorm:"default:null;index"`
+	BookingID   *uint       `json:"booking_id" gorm:"default:null;index"` // diisi jika bagian dari booking grup
 	RentDate    time.Time   `json:"rent_date"`
+	// Periode yang direncanakan saat booking (reservasi maupun walk-in).
+	// PlannedEndDate sekaligus menjadi expected return untuk deteksi overdue.
+	PlannedStartDate time.Time  `json:"planned_start_date"`
+	PlannedEndDate   *time.Time `json:"planned_end_date" gorm:"default:null"`
 	ReturnDate  *time.Time  `json:"return_date" gorm:"default:null"`
 	TotalPrice  float64     `json:"total_price"`
 	Status      RentStatus  `json:"status"`
 	Notes       string      `json:"notes"`
+	PromoID     *uint       `json:"promo_id" gorm:"default:null"`
+	// Branch pickup & drop-off yang direncanakan, ReturnBranchID = tempat
+	// kendaraan benar-benar dikembalikan. OneWayFee = estimasi saat booking,
+	// tagihan final dihitung dari rute pickup -> branch pengembalian.
+	PickupBranchID  *uint   `json:"pickup_branch_id" gorm:"default:null;index"`
+	DropoffBranchID *uint   `json:"dropoff_branch_id" gorm:"default:null;index"`
+	ReturnBranchID  *uint   `json:"return_branch_id" gorm:"default:null"`
+	OneWayFee       float64 `json:"one_way_fee"`
 
 	CreatedByID uint `json:"created_by_id"`
 	UpdatedByID uint `json:"updated_by_id"`
+	UpdatedAt   time.Time `json:"updated_at"`
 
 	// Relations
 	CreatedBy user.User         `json:"created_by" gorm:"foreignKey:CreatedByID"`
 	UpdatedBy user.User         `json:"updated_by" gorm:"foreignKey:UpdatedByID"`
 	Customer  customer.Customer `json:"customer"   gorm:"foreignKey:CustomerID"`
 	Vehicle   vehicle.Vehicle   `json:"vehicle"    gorm:"foreignKey:VehicleID"`
+	Class     *vehicleclass.Class `json:"class"    gorm:"foreignKey:ClassID"`
+	Promo     *promo.Promo      `json:"promo"      gorm:"foreignKey:PromoID"`
+	PickupBranch  *branch.Branch `json:"pickup_branch"  gorm:"foreignKey:PickupBranchID"`
+	DropoffBranch *branch.Branch `json:"dropoff_branch" gorm:"foreignKey:DropoffBranchID"`
+	ReturnBranch  *branch.Branch `json:"return_branch"  gorm:"foreignKey:ReturnBranchID"`
+	Charges   []RentCharge      `json:"charges"    gorm:"foreignKey:RentID"`
+	Extras    []extra.RentExtra `json:"extras"     gorm:"foreignKey:RentID"`
+	Drivers   []RentDriver      `json:"drivers"    gorm:"foreignKey:RentID"`
+	Segments  []RentSegment     `json:"segments"   gorm:"foreignKey:RentID"`
+	Inspections []inspection.Inspection `json:"-" gorm:"foreignKey:RentID"`
+	History   []RentStatusHistory `json:"history"    gorm:"foreignKey:RentID"`
+}
+
+// RentSegment mencatat kendaraan yang dipakai dalam satu rentang waktu rent.
+// Rent tanpa tukar kendaraan hanya punya satu segment; EndAt nil = masih berjalan.
+type RentSegment struct {
+	ID          uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID      uint       `json:"rent_id" gorm:"index"`
+	VehicleID   uint       `json:"vehicle_id"`
+	StartAt     time.Time  `json:"start_at"`
+	EndAt       *time.Time `json:"end_at" gorm:"default:null"`
+	Reason      string     `json:"reason"`
+	CreatedByID uint       `json:"created_by_id"`
+
+	Vehicle vehicle.Vehicle `json:"vehicle" gorm:"foreignKey:VehicleID"`
+}
+
+// MarshalJSON menampilkan waktu RentSegment dalam zona bisnis
+func (r RentSegment) MarshalJSON() ([]byte, error) {
+	type alias RentSegment
+	r.StartAt = clock.In(r.StartAt)
+	r.EndAt = clock.InPtr(r.EndAt)
+	return json.Marshal(alias(r))
+}
+
+// RentDriver adalah pengemudi tambahan yang diizinkan selain customer penyewa.
+// Pengemudi tetap disimpan sebagai customer supaya datanya bisa dipakai ulang.
+type RentDriver struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID     uint      `json:"rent_id" gorm:"uniqueIndex:idx_rent_driver"`
+	CustomerID uint      `json:"customer_id" gorm:"uniqueIndex:idx_rent_driver"`
+	AddedByID  uint      `json:"added_by_id"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	Customer customer.Customer `json:"customer" gorm:"foreignKey:CustomerID"`
+}
+
+// MarshalJSON menampilkan waktu RentDriver dalam zona bisnis
+func (r RentDriver) MarshalJSON() ([]byte, error) {
+	type alias RentDriver
+	r.CreatedAt = clock.In(r.CreatedAt)
+	return json.Marshal(alias(r))
+}
+
+// RentStatusHistory mencatat setiap perpindahan status rent beserta alasannya
+type RentStatusHistory struct {
+	ID          uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID      uint       `json:"rent_id" gorm:"index"`
+	FromStatus  RentStatus `json:"from_status" gorm:"type:varchar(20)"`
+	ToStatus    RentStatus `json:"to_status" gorm:"type:varchar(20)"`
+	Reason      string     `json:"reason"`
+	ChangedByID uint       `json:"changed_by_id"`
+	ChangedAt   time.Time  `json:"changed_at"`
+
+	ChangedBy user.User `json:"changed_by" gorm:"foreignKey:ChangedByID"`
+}
+
+// MarshalJSON menampilkan waktu RentStatusHistory dalam zona bisnis
+func (r RentStatusHistory) MarshalJSON() ([]byte, error) {
+	type alias RentStatusHistory
+	r.ChangedAt = clock.In(r.ChangedAt)
+	return json.Marshal(alias(r))
+}
+
+// RentCharge adalah satu baris rincian tagihan rent.
+// TotalPrice pada Rent selalu sama dengan jumlah Amount semua charge.
+type RentCharge struct {
+	ID          uint               `json:"id" gorm:"primaryKey;autoIncrement"`
+	RentID      uint               `json:"rent_id" gorm:"index"`
+	Type        pricing.ChargeType `json:"type" gorm:"type:varchar(50)"`
+	Description string             `json:"description"`
+	Quantity    float64            `json:"quantity"`
+	UnitPrice   float64            `json:"unit_price"`
+	Amount      float64            `json:"amount"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// MarshalJSON menampilkan waktu RentCharge dalam zona bisnis
+func (r RentCharge) MarshalJSON() ([]byte, error) {
+	type alias RentCharge
+	r.CreatedAt = clock.In(r.CreatedAt)
+	return json.Marshal(alias(r))
 }
 
 type RentRequest struct {
     CustomerID uint   `json:"customer_id" form:"customer_id" binding:"required"`
-    VehicleID  uint   `json:"vehicle_id"  form:"vehicle_id"  binding:"required"`
+    // Isi salah satu: VehicleID untuk kendaraan tertentu, ClassID untuk
+    // reservasi per class (kendaraan dipilih saat pickup)
+    VehicleID  *uint  `json:"vehicle_id"  form:"vehicle_id"`
+    ClassID    *uint  `json:"class_id"    form:"class_id"`
     Notes      string `json:"notes"        form:"notes"`
+    // StartDate kosong atau sudah lewat = walk-in, langsung ongoing.
+    // StartDate di masa depan = reservasi.
+    StartDate  *time.Time `json:"start_date" form:"start_date"`
+    // EndDate = rencana waktu pengembalian (expected return), wajib diisi
+    EndDate    *time.Time `json:"end_date"   form:"end_date"   binding:"required"`
+    // PromoCode opsional, divalidasi dan dipakai kuotanya saat rent dibuat
+    PromoCode  *string    `json:"promo_code" form:"promo_code"`
+    // Extras opsional, stoknya dipesan untuk periode sewa
+    Extras     []extra.ItemRequest `json:"extras" form:"extras" binding:"omitempty,dive"`
+    // DriverIDs = customer lain yang ikut diizinkan mengemudi
+    DriverIDs  []uint     `json:"driver_ids" form:"driver_ids"`
+    // Branch opsional: pickup default lokasi kendaraan, drop-off default
+    // sama dengan pickup. Drop-off berbeda = one-way rent.
+    PickupBranchID  *uint `json:"pickup_branch_id"  form:"pickup_branch_id"`
+    DropoffBranchID *uint `json:"dropoff_branch_id" form:"dropoff_branch_id"`
+    // BookingID diisi oleh package booking, tidak dari request
+    BookingID  *uint      `json:"-" form:"-"`
 }
 
 
 
 type RentResponse struct {
 	ID          uint        			`json:"id"`
+	BookingID   *uint             `json:"booking_id,omitempty"`
 	Customer    customer.Customer `json:"customer"`
-	Vehicle     vehicle.Vehicle   `json:"vehicle"`
+	Vehicle     *vehicle.Vehicle  `json:"vehicle"` // nil = reservasi class belum di-assign
+	Class       *vehicleclass.Class `json:"class"`
 	RentDate    string      			`json:"rent_date"`
 	ReturnDate  string      			`json:"return_date"`
+	PlannedStartDate string      `json:"planned_start_date"`
+	PlannedEndDate   string      `json:"planned_end_date"`
 	TotalPrice  float64    				`json:"total_price"`
+	Charges     []RentCharge      `json:"charges"`
+	PromoCode   string            `json:"promo_code,omitempty"`
+	PickupBranch  *branch.Branch  `json:"pickup_branch"`
+	DropoffBranch *branch.Branch  `json:"dropoff_branch"`
+	ReturnBranch  *branch.Branch  `json:"return_branch"`
+	OneWayFee     float64         `json:"one_way_fee"`
+	Extras      []extra.RentExtra `json:"extras"`
+	Drivers     []customer.Customer `json:"drivers"`
+	Segments    []RentSegment     `json:"segments"`
+	Inspections []inspection.Comparison `json:"inspections"` // satu per kendaraan
 	Status      RentStatus 				`json:"status"`
+	Overdue     bool              `json:"overdue"`
+	History     []RentStatusHistory `json:"history"`
+	Warnings    []string          `json:"warnings,omitempty"`
 	Notes       string     				`json:"notes"`
 	CreatedBy   user.User         `json:"created_by"`
 	UpdatedBy   user.User         `json:"updated_by"`
+	UpdatedAt   string            `json:"updated_at"`
 }
 
+// UpdateRentRequest hanya untuk data non-status.
+// Perubahan status lewat endpoint transisi (pickup, complete, cancel, no-show, close).
 type UpdateRentRequest struct {
-    Status *string `json:"status" form:"status" binding:"omitempty"`
     Notes  *string `json:"notes"  form:"notes"  binding:"omitempty"`
 }
+
+// CompleteRentRequest = TransitionRequest + branch tempat kendaraan
+// dikembalikan (default: drop-off branch rent)
+type CompleteRentRequest struct {
+	Reason         string `json:"reason" form:"reason" binding:"required"`
+	ReturnBranchID *uint  `json:"return_branch_id" form:"return_branch_id"`
+}
+
+// SwapRentRequest mengganti kendaraan rent ongoing. Checkin (kendaraan lama) dan
+// checkout (kendaraan pengganti) wajib dicatat bersamaan dengan tukar kendaraan.
+// SetMaintenance default true: kendaraan lama dianggap rusak.
+type SwapRentRequest struct {
+	VehicleID      uint                      `json:"vehicle_id" form:"vehicle_id" binding:"required"`
+	Reason         string                    `json:"reason" form:"reason" binding:"required"`
+	SetMaintenance *bool                     `json:"set_maintenance" form:"set_maintenance"`
+	Checkin        inspection.SwapInspection `json:"checkin"`
+	Checkout       inspection.SwapInspection `json:"checkout"`
+}
+
+// DriverRequest menambah pengemudi: customer yang sudah ada (customer_id)
+// atau customer baru (customer), salah satu saja
+type DriverRequest struct {
+	CustomerID *uint                     `json:"customer_id"`
+	Customer   *customer.CustomerRequest `json:"customer"`
+}
+
+// TransitionRequest dipakai semua endpoint transisi status
+type TransitionRequest struct {
+	Reason string `json:"reason" form:"reason" binding:"required"`
+}
+
+type ExtendRentRequest struct {
+	EndDate time.Time `json:"end_date" form:"end_date" binding:"required"`
+	Notes   *string   `json:"notes"    form:"notes"    binding:"omitempty"`
+}
+
+// RentFilter untuk GET /api/rent/, semua field opsional.
+// Sort: id, rent_date, planned_start_date, total_price, updated_at.
+// Pagination berbasis cursor: kirim next_cursor dari response sebelumnya.
+type RentFilter struct {
+	Status      *string    `form:"status"`
+	CustomerID  *uint      `form:"customer_id"`
+	VehicleID   *uint      `form:"vehicle_id"`
+	ClassID     *uint      `form:"class_id"`
+	BookingID   *uint      `form:"booking_id"`
+	CreatedByID *uint      `form:"created_by_id"`
+	RentFrom    *time.Time `form:"rent_from"`
+	RentTo      *time.Time `form:"rent_to"`
+	ReturnFrom  *time.Time `form:"return_from"`
+	ReturnTo    *time.Time `form:"return_to"`
+	MinTotal    *float64   `form:"min_total"`
+	MaxTotal    *float64   `form:"max_total"`
+	PickupBranchID  *uint  `form:"pickup_branch_id"`
+	DropoffBranchID *uint  `form:"dropoff_branch_id"`
+
+	Sort   string `form:"sort"   binding:"omitempty,oneof=id rent_date planned_start_date total_price updated_at"`
+	Order  string `form:"order"  binding:"omitempty,oneof=asc desc"`
+	Limit  int    `form:"limit"  binding:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor"`
+}
+
+// PageCursor adalah posisi baris terakhir halaman sebelumnya.
+// Value bertipe sesuai kolom sort (time.Time atau float64), nil jika sort = id.
+type PageCursor struct {
+	Value interface{}
+	ID    uint
+}
+
+type RentListResponse struct {
+	Items      []*RentResponse `json:"items"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type ExtendRentResponse struct {
+	Rent           *RentResponse  `json:"rent"`
+	ProjectedPrice *pricing.Quote `json:"projected_price"`
+}
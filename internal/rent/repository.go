@@ -1,18 +1,34 @@
 package rent
 
 import (
+	"time"
+
 	"go-rental/internal/customer"
 	"go-rental/internal/user"
 	"go-rental/internal/vehicle"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository interface {
 	Create(rent *Rent) error
 	FindByID(id uint) (*Rent, error)
-	FindAll() ([]*Rent, error)
+	FindByIDForUpdate(id uint) (*Rent, error)
+	FindAll(filter *RentFilter, after *PageCursor) ([]*Rent, error)
+	FindOverdue(deadline time.Time) ([]*Rent, error)
 	Update(rent *Rent) error
+	HasOverlap(vehicleID uint, start time.Time, end *time.Time, excludeID uint) (bool, error)
+	FindClassRents(classID uint, vehicleIDs []uint, start, end time.Time, excludeID uint) ([]*Rent, error)
+	CreateCharges(charges []*RentCharge) error
+	SumCharges(rentID uint) (float64, error)
+	CreateHistory(history *RentStatusHistory) error
+	AddDriver(driver *RentDriver) error
+	CreateSegment(segment *RentSegment) error
+	FindSegments(rentID uint) ([]*RentSegment, error)
+	CloseOpenSegment(rentID uint, at time.Time) error
+	RemoveDriver(rentID, customerID uint) (bool, error)
+	WithTx(tx *gorm.DB) Repository
 }
 
 type repository struct {
@@ -28,9 +44,101 @@ func (r *repository) Create(rent *Rent) error {
 
 
 // FindAll implements Repository.
-func (r *repository) FindAll() ([]*Rent, error) {
+// filter sudah dinormalisasi service (Sort, Order, Limit terisi). after nil = halaman pertama.
+// Mengambil Limit+1 baris supaya service tahu masih ada halaman berikutnya.
+func (r *repository) FindAll(filter *RentFilter, after *PageCursor) ([]*Rent, error) {
 	var rents []*Rent
-	if err := r.db.Preload("CreatedBy").Preload("UpdatedBy").Preload("Vehicle").Preload("Customer").Find(&rents).Error; err != nil {
+	query := applyRentFilter(r.db.Model(&Rent{}), filter)
+
+	// Keyset pagination pada (sort, id) supaya urutan stabil walau nilai sort sama
+	cmp := ">"
+	if filter.Order == "desc" {
+		cmp = "<"
+	}
+	if after != nil {
+		if filter.Sort == "id" {
+			query = query.Where("id "+cmp+" ?", after.ID)
+		} else {
+			query = query.Where(
+				"("+filter.Sort+" "+cmp+" ?) OR ("+filter.Sort+" = ? AND id "+cmp+" ?)",
+				after.Value, after.Value, after.ID,
+			)
+		}
+	}
+	if filter.Sort != "id" {
+		query = query.Order(filter.Sort + " " + filter.Order)
+	}
+	query = query.Order("id " + filter.Order).Limit(filter.Limit + 1)
+
+	if err := query.Preload("CreatedBy").Preload("UpdatedBy").Preload("Vehicle").Preload("Class").Preload("Customer").Preload("Promo").Preload("Charges").
+		Preload("PickupBranch").Preload("DropoffBranch").Preload("ReturnBranch").
+		Find(&rents).Error; err != nil {
+		return nil, err
+	}
+	return rents, nil
+}
+
+// applyRentFilter menerapkan kriteria RentFilter selain sort & pagination
+func applyRentFilter(query *gorm.DB, filter *RentFilter) *gorm.DB {
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.CustomerID != nil {
+		query = query.Where("customer_id = ?", *filter.CustomerID)
+	}
+	if filter.VehicleID != nil {
+		query = query.Where("vehicle_id = ?", *filter.VehicleID)
+	}
+	if filter.ClassID != nil {
+		query = query.Where("class_id = ?", *filter.ClassID)
+	}
+	if filter.BookingID != nil {
+		query = query.Where("booking_id = ?", *filter.BookingID)
+	}
+	if filter.CreatedByID != nil {
+		query = query.Where("created_by_id = ?", *filter.CreatedByID)
+	}
+	// FILTER BRANCH
+	if filter.PickupBranchID != nil {
+		query = query.Where("pickup_branch_id = ?", *filter.PickupBranchID)
+	}
+	if filter.DropoffBranchID != nil {
+		query = query.Where("dropoff_branch_id = ?", *filter.DropoffBranchID)
+	}
+	// FILTER RENT DATE RANGE
+	if filter.RentFrom != nil {
+		query = query.Where("rent_date >= ?", *filter.RentFrom)
+	}
+	if filter.RentTo != nil {
+		query = query.Where("rent_date < ?", *filter.RentTo)
+	}
+	// FILTER RETURN DATE RANGE
+	if filter.ReturnFrom != nil {
+		query = query.Where("return_date >= ?", *filter.ReturnFrom)
+	}
+	if filter.ReturnTo != nil {
+		query = query.Where("return_date < ?", *filter.ReturnTo)
+	}
+	// FILTER TOTAL PRICE
+	if filter.MinTotal != nil {
+		query = query.Where("total_price >= ?", *filter.MinTotal)
+	}
+	if filter.MaxTotal != nil {
+		query = query.Where("total_price <= ?", *filter.MaxTotal)
+	}
+	return query
+}
+
+// FindOverdue implements Repository.
+// Rent ongoing yang expected return-nya sudah lewat dari deadline.
+func (r *repository) FindOverdue(deadline time.Time) ([]*Rent, error) {
+	var rents []*Rent
+	err := r.db.Preload("CreatedBy").Preload("UpdatedBy").Preload("Vehicle").Preload("Customer").Preload("Promo").Preload("Charges").
+		Where("status = ?", StatusOngoing).
+		Where("planned_end_date IS NOT NULL AND planned_end_date < ?", deadline).
+		Order("planned_end_date asc").
+		Find(&rents).Error
+	if err != nil {
 		return nil, err
 	}
 	return rents, nil
@@ -39,7 +147,23 @@ func (r *repository) FindAll() ([]*Rent, error) {
 // FindByID implements Repository.
 func (r *repository) FindByID(id uint) (*Rent, error) {
 	var rent Rent
-	if err := r.db.Preload("CreatedBy").Preload("UpdatedBy").Preload("Vehicle").Preload("Customer").First(&rent, id).Error; err != nil {
+	if err := r.db.Preload("CreatedBy").Preload("UpdatedBy").Preload("Vehicle").Preload("Class").Preload("Customer").Preload("Promo").Preload("Charges").
+		Preload("Extras.Extra").Preload("Drivers.Customer").
+		Preload("PickupBranch").Preload("DropoffBranch").Preload("ReturnBranch").
+		Preload("Segments", func(db *gorm.DB) *gorm.DB { return db.Order("start_at asc, id asc") }).Preload("Segments.Vehicle").
+		Preload("Inspections.Photos").Preload("Inspections.InspectedBy").
+		Preload("History", func(db *gorm.DB) *gorm.DB { return db.Order("changed_at asc, id asc") }).Preload("History.ChangedBy").
+		First(&rent, id).Error; err != nil {
+		return nil, err
+	}
+	return &rent, nil
+}
+
+// FindByIDForUpdate implements Repository.
+// Mengunci baris rent tanpa preload relasi, hanya berguna di dalam transaksi.
+func (r *repository) FindByIDForUpdate(id uint) (*Rent, error) {
+	var rent Rent
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&rent, id).Error; err != nil {
 		return nil, err
 	}
 	return &rent, nil
@@ -50,6 +174,115 @@ func (r *repository) Update(rent *Rent) error {
 	return r.db.Save(rent).Error
 }
 
+// HasOverlap implements Repository.
+// Mengecek apakah ada reservasi atau rent ongoing pada kendaraan yang sama
+// yang periodenya beririsan dengan [start, end). end nil berarti tanpa batas akhir.
+func (r *repository) HasOverlap(vehicleID uint, start time.Time, end *time.Time, excludeID uint) (bool, error) {
+	query := r.db.Model(&Rent{}).
+		Where("vehicle_id = ?", vehicleID).
+		Where("status IN ?", []RentStatus{StatusReserved, StatusOngoing}).
+		Where("planned_end_date IS NULL OR planned_end_date > ?", start)
+	if end != nil {
+		query = query.Where("COALESCE(planned_start_date, rent_date) < ?", *end)
+	}
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindClassRents implements Repository.
+// Reservasi / rent ongoing yang memakai kapasitas class selama [start, end):
+// reservasi class yang belum di-assign dan rent pada kendaraan class tersebut.
+func (r *repository) FindClassRents(classID uint, vehicleIDs []uint, start, end time.Time, excludeID uint) ([]*Rent, error) {
+	members := "(vehicle_id IS NULL AND class_id = ?)"
+	args := []interface{}{classID}
+	if len(vehicleIDs) > 0 {
+		members = "((vehicle_id IS NULL AND class_id = ?) OR vehicle_id IN ?)"
+		args = append(args, vehicleIDs)
+	}
+	query := r.db.Model(&Rent{}).
+		Where(members, args...).
+		Where("status IN ?", []RentStatus{StatusReserved, StatusOngoing}).
+		Where("planned_end_date IS NULL OR planned_end_date > ?", start).
+		Where("COALESCE(planned_start_date, rent_date) < ?", end)
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	var rents []*Rent
+	if err := query.Find(&rents).Error; err != nil {
+		return nil, err
+	}
+	return rents, nil
+}
+
+// CreateCharges implements Repository.
+func (r *repository) CreateCharges(charges []*RentCharge) error {
+	if len(charges) == 0 {
+		return nil
+	}
+	return r.db.Create(&charges).Error
+}
+
+// SumCharges implements Repository.
+func (r *repository) SumCharges(rentID uint) (float64, error) {
+	var total float64
+	err := r.db.Model(&RentCharge{}).
+		Where("rent_id = ?", rentID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&total).Error
+	return total, err
+}
+
+// CreateHistory implements Repository.
+func (r *repository) CreateHistory(history *RentStatusHistory) error {
+	return r.db.Create(history).Error
+}
+
+// CreateSegment implements Repository.
+func (r *repository) CreateSegment(segment *RentSegment) error {
+	return r.db.Omit("Vehicle").Create(segment).Error
+}
+
+// FindSegments implements Repository.
+func (r *repository) FindSegments(rentID uint) ([]*RentSegment, error) {
+	var segments []*RentSegment
+	if err := r.db.Where("rent_id = ?", rentID).Order("start_at asc, id asc").Find(&segments).Error; err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+// CloseOpenSegment implements Repository.
+func (r *repository) CloseOpenSegment(rentID uint, at time.Time) error {
+	return r.db.Model(&RentSegment{}).
+		Where("rent_id = ? AND end_at IS NULL", rentID).
+		Update("end_at", at).Error
+}
+
+// AddDriver implements Repository.
+func (r *repository) AddDriver(driver *RentDriver) error {
+	return r.db.Omit("Customer").Create(driver).Error
+}
+
+// RemoveDriver implements Repository.
+// false jika customer memang bukan pengemudi rent tersebut.
+func (r *repository) RemoveDriver(rentID, customerID uint) (bool, error) {
+	result := r.db.Where("rent_id = ? AND customer_id = ?", rentID, customerID).Delete(&RentDriver{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
 func NewRepository(db *gorm.DB, userRepo user.Repository, vehicleRepo vehicle.Repository, customerRepo customer.Repository) Repository {
 	return &repository{
 		db: db,
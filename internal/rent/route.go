@@ -12,7 +12,17 @@ func RentSetupRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
 	{
 		rent.POST("/", middlewares.Authenticate(cfg), ctrl.CreateRent)
 		rent.GET("/", middlewares.Authenticate(cfg), ctrl.GetRents)
+		rent.GET("/overdue", middlewares.Authenticate(cfg), ctrl.GetOverdueRents)
 		rent.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetRentByID)
 		rent.PUT("/:id/", middlewares.Authenticate(cfg), ctrl.UpdateRent)
+		rent.POST("/:id/pickup", middlewares.Authenticate(cfg), ctrl.PickupRent)
+		rent.POST("/:id/complete", middlewares.Authenticate(cfg), ctrl.CompleteRent)
+		rent.POST("/:id/cancel", middlewares.Authenticate(cfg), ctrl.CancelRent)
+		rent.POST("/:id/no-show", middlewares.Authenticate(cfg), ctrl.NoShowRent)
+		rent.POST("/:id/close", middlewares.Authenticate(cfg), ctrl.CloseRent)
+		rent.POST("/:id/extend", middlewares.Authenticate(cfg), ctrl.ExtendRent)
+		rent.POST("/:id/swap", middlewares.Authenticate(cfg), ctrl.SwapVehicle)
+		rent.POST("/:id/drivers", middlewares.Authenticate(cfg), ctrl.AddDriver)
+		rent.DELETE("/:id/drivers/:customer_id", middlewares.Authenticate(cfg), ctrl.RemoveDriver)
 	}
 }
\ No newline at end of file
@@ -2,75 +2,1021 @@ package rent
 
 import (
 	"errors"
+	"fmt"
+	"go-rental/internal/branch"
+	"go-rental/internal/customer"
+	"go-rental/internal/document"
+	"go-rental/internal/extra"
+	"go-rental/internal/inspection"
+	"go-rental/internal/maintenance"
+	"go-rental/internal/pricing"
+	"go-rental/internal/promo"
 	"go-rental/internal/vehicle"
+	"go-rental/internal/vehicleclass"
+	"go-rental/pkg/clock"
 	"go-rental/pkg/config"
+	"log"
+	"math"
+	"strconv"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Service interface {
 	CreateRent(req *RentRequest, createdBy uint) (*RentResponse, error)
+	CreateRentTx(repos *Repositories, req *RentRequest, createdBy uint) (*Rent, error)
 	GetRentByID(id uint) (*RentResponse, error)
-	GetAllRents() ([]*RentResponse, error)
+	GetAllRents(filter *RentFilter) (*RentListResponse, error)
+	GetOverdueRents() ([]*RentResponse, error)
 	UpdateRent(id uint, req *UpdateRentRequest, updatedBy uint) (*RentResponse, error)
+	PickupRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error)
+	CompleteRent(id uint, req *CompleteRentRequest, updatedBy uint) (*RentResponse, error)
+	CancelRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error)
+	CancelRentTx(repos *Repositories, id uint, req *TransitionRequest, updatedBy uint) error
+	NoShowRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error)
+	CloseRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error)
+	ExtendRent(id uint, req *ExtendRentRequest, updatedBy uint) (*ExtendRentResponse, error)
+	EstimateRent(id uint) (*pricing.Quote, error)
+	AddDriver(id uint, req *DriverRequest, updatedBy uint) (*RentResponse, error)
+	RemoveDriver(id uint, customerID uint, updatedBy uint) (*RentResponse, error)
+	SwapVehicle(id uint, req *SwapRentRequest, updatedBy uint) (*RentResponse, error)
+	ClassAvailability(classID uint, from, to time.Time) (*vehicleclass.Availability, error)
+	ClassFreeCount(classID uint, from, to time.Time) (int, error)
+	ClassFreeCountTx(tx *gorm.DB, classID uint, from, to time.Time) (int, error)
+	ToResponse(rent *Rent) *RentResponse
 }
 
 type service struct {
 	vehicleRepo vehicle.Repository
 	repo        Repository
+	uow         UnitOfWork
+	pricing     pricing.Service
+	promos      promo.Service
+	extras      extra.Service
+	maintenance maintenance.Service
+	documents   document.Service
+	branches    branch.Service
+	classes     vehicleclass.Service
+	inspections inspection.Service
+	payments    PaymentLedger
+	invoices    InvoiceIssuer
     cfg         config.Config
 }
 
 // CreateRent implements Service.
 func (s *service) CreateRent(req *RentRequest, createdBy uint) (*RentResponse, error) {
-    // 1. Cek vehicle
-    vh, err := s.vehicleRepo.FindByID(req.VehicleID)
-    if err != nil {
-        return nil, errors.New("vehicle not found")
-    }
-    if vh.Status != vehicle.StatusAvailable {
-        return nil, errors.New("vehicle is not available")
-    }    // 2. Buat rent dengan RentDate otomatis (sekarang)
-    rent := &Rent{
-        CustomerID:  req.CustomerID,
-        VehicleID:   req.VehicleID,
-        RentDate:    time.Now(), // Set otomatis saat dibuat
-        Status:      StatusOngoing,
-        Notes:       req.Notes,
-        TotalPrice:  0, // Akan dihitung saat completed
-        CreatedByID: createdBy,
-        UpdatedByID: createdBy,
-    }
-
-    if err := s.repo.Create(rent); err != nil {
-        return nil, err
-    }
-
-    // 3. Update status kendaraan
-    vh.Status = vehicle.StatusRented
-    if err := s.vehicleRepo.Update(vh); err != nil {
-        return nil, errors.New("failed to update vehicle status")
-    }
-
-    // 4. Load relasi (customer, vehicle, created_by, updated_by)
-    createdRent, err := s.repo.FindByID(rent.ID)
-    if err != nil {
-        return nil, err
-    }
-
-    return ToRentResponse(createdRent), nil
+	var rent *Rent
+	err := s.uow.Do(func(repos *Repositories) error {
+		var err error
+		rent, err = s.CreateRentTx(repos, req, createdBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Load relasi (customer, vehicle, created_by, updated_by)
+	createdRent, err := s.repo.FindByID(rent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(createdRent), nil
+}
+
+// CreateRentTx implements Service.
+// Dipakai CreateRent dan booking grup yang membuat beberapa rent sekaligus.
+func (s *service) CreateRentTx(repos *Repositories, req *RentRequest, createdBy uint) (*Rent, error) {
+	now := time.Now()
+
+	// 1. Tentukan periode sewa: reservasi jika start_date di masa depan
+	reservation := req.StartDate != nil && req.StartDate.After(now)
+	start := now
+	if reservation {
+		start = *req.StartDate
+	}
+	if req.EndDate == nil || !req.EndDate.After(start) {
+		return nil, errors.New("end_date must be after start_date")
+	}
+
+	if (req.VehicleID == nil) == (req.ClassID == nil) {
+		return nil, errors.New("exactly one of vehicle_id or class_id is required")
+	}
+
+	// 2. Cek customer
+	if _, err := repos.Customer.FindByID(req.CustomerID); err != nil {
+		return nil, errors.New("customer not found")
+	}
+
+	// 3. Kunci class lalu kendaraan, sehingga booking paralel untuk class
+	// atau kendaraan yang sama harus antre di sini. Reservasi per class
+	// belum memegang kendaraan; walk-in per class langsung dipilihkan.
+	var vh *vehicle.Vehicle
+	var class *vehicleclass.Class
+	var err error
+	if req.ClassID != nil {
+		if class, err = s.classes.Lock(repos.Tx, *req.ClassID); err != nil {
+			return nil, err
+		}
+		if !class.Active {
+			return nil, fmt.Errorf("vehicle class %s is not available for booking", class.Name)
+		}
+		if !reservation {
+			if vh, err = s.assignClassVehicle(repos, class, req.PickupBranchID, start, *req.EndDate); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if vh, class, err = s.lockVehicle(repos, *req.VehicleID); err != nil {
+			return nil, err
+		}
+		if !reservation && vh.Status != vehicle.StatusAvailable {
+			return nil, fmt.Errorf("vehicle %s is not available", vh.PlateNumber)
+		}
+	}
+
+	// 4. Tolak jika beririsan dengan reservasi / rent ongoing lain
+	if vh != nil {
+		overlap, err := repos.Rent.HasOverlap(vh.ID, start, req.EndDate, 0)
+		if err != nil {
+			return nil, err
+		}
+		if overlap {
+			return nil, fmt.Errorf("vehicle %s is already booked for the requested period", vh.PlateNumber)
+		}
+		if err := s.maintenance.CheckWindow(repos.Tx, vh.ID, start, req.EndDate); err != nil {
+			return nil, err
+		}
+		if err := s.documents.CheckValid(repos.Tx, vh.ID, start, req.EndDate); err != nil {
+			return nil, fmt.Errorf("vehicle %s cannot be rented: %w", vh.PlateNumber, err)
+		}
+	}
+	// Kendaraan class juga dipakai reservasi class yang belum di-assign
+	if class != nil && (vh == nil || vh.Status != vehicle.StatusMaintenance) {
+		if err := s.checkClassCapacity(repos, class, start, *req.EndDate, 0); err != nil {
+			return nil, err
+		}
+	}
+	pickupBranchID, dropoffBranchID, oneWayFee, err := s.resolveBranches(repos, req, vh, reservation)
+	if err != nil {
+		return nil, err
+	}
+	var vehicleType vehicle.VehicleType
+	if vh != nil {
+		vehicleType = vh.Type
+	} else {
+		vehicleType = class.Type
+	}
+
+	// 5. Buat rent. Untuk reservasi, RentDate diisi rencana pickup
+	// dan akan ditimpa dengan waktu pickup sebenarnya.
+	status := StatusOngoing
+	if reservation {
+		status = StatusReserved
+	}
+	rent := &Rent{
+		CustomerID:       req.CustomerID,
+		BookingID:        req.BookingID,
+		RentDate:         start,
+		PlannedStartDate: start,
+		PlannedEndDate:   req.EndDate,
+		PickupBranchID:   pickupBranchID,
+		DropoffBranchID:  dropoffBranchID,
+		OneWayFee:        oneWayFee,
+		Status:           status,
+		Notes:            req.Notes,
+		TotalPrice:       0, // Akan dihitung saat completed
+		CreatedByID:      createdBy,
+		UpdatedByID:      createdBy,
+	}
+	if vh != nil {
+		rent.VehicleID = &vh.ID
+	}
+	if class != nil {
+		rent.ClassID = &class.ID
+	}
+	if err := repos.Rent.Create(rent); err != nil {
+		return nil, err
+	}
+
+	// 6. Validasi & pakai kuota promo, baris promo terkunci sampai commit
+	if req.PromoCode != nil && *req.PromoCode != "" {
+		p, err := s.promos.Redeem(repos.Tx, &promo.RedeemInput{
+			Code:        *req.PromoCode,
+			RentID:      rent.ID,
+			CustomerID:  rent.CustomerID,
+			VehicleType: string(vehicleType),
+			Start:       start,
+			End:         *req.EndDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rent.PromoID = &p.ID
+		if err := repos.Rent.Update(rent); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Pesan stok extras untuk periode sewa, baris extra terkunci sampai commit
+	if len(req.Extras) > 0 {
+		if err := s.extras.Reserve(repos.Tx, rent.ID, req.Extras, vehicleType, start, req.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	// 8. Pengemudi tambahan
+	for _, driverID := range req.DriverIDs {
+		if err := s.addDriver(repos, rent, driverID, createdBy); err != nil {
+			return nil, err
+		}
+	}
+
+	reason := "rent created"
+	if reservation {
+		reason = "reservation created"
+	}
+	if err := repos.Rent.CreateHistory(&RentStatusHistory{
+		RentID:      rent.ID,
+		ToStatus:    status,
+		Reason:      reason,
+		ChangedByID: createdBy,
+		ChangedAt:   time.Now(),
+	}); err != nil {
+		return nil, errors.New("failed to record rent history")
+	}
+
+	// 9. Update status kendaraan, reservasi tetap available sampai pickup
+	if !reservation {
+		if err := repos.Rent.CreateSegment(&RentSegment{
+			RentID:      rent.ID,
+			VehicleID:   vh.ID,
+			StartAt:     start,
+			Reason:      "pickup",
+			CreatedByID: createdBy,
+		}); err != nil {
+			return nil, errors.New("failed to record rent segment")
+		}
+		vh.Status = vehicle.StatusRented
+		if err := repos.Vehicle.Update(vh); err != nil {
+			return nil, errors.New("failed to update vehicle status")
+		}
+	}
+	return rent, nil
+}
+
+// PickupRent implements Service.
+func (s *service) PickupRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error) {
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if !rent.Status.CanTransitionTo(StatusOngoing) {
+			return fmt.Errorf("cannot pick up %s rent", rent.Status)
+		}
+
+		now := time.Now()
+		var vh *vehicle.Vehicle
+		if rent.VehicleID == nil {
+			// Reservasi class: kendaraan baru dipilih sekarang
+			if rent.ClassID == nil || rent.PlannedEndDate == nil {
+				return errors.New("rent has no vehicle or vehicle class")
+			}
+			class, err := s.classes.Lock(repos.Tx, *rent.ClassID)
+			if err != nil {
+				return err
+			}
+			if vh, err = s.assignClassVehicle(repos, class, rent.PickupBranchID, now, *rent.PlannedEndDate); err != nil {
+				return err
+			}
+			rent.VehicleID = &vh.ID
+		} else {
+			vh, err = repos.Vehicle.FindByIDForUpdate(*rent.VehicleID)
+			if err != nil {
+				return errors.New("vehicle not found")
+			}
+			if vh.Status != vehicle.StatusAvailable {
+				return errors.New("vehicle is not available")
+			}
+			if rent.PickupBranchID != nil && vh.BranchID != nil && *vh.BranchID != *rent.PickupBranchID {
+				return fmt.Errorf("vehicle %s is not at the pickup branch", vh.PlateNumber)
+			}
+			// Dokumen bisa habis antara reservasi dan pickup
+			if err := s.documents.CheckValid(repos.Tx, vh.ID, now, rent.PlannedEndDate); err != nil {
+				return fmt.Errorf("vehicle %s cannot be rented: %w", vh.PlateNumber, err)
+			}
+		}
+
+		// RentDate = waktu pickup sebenarnya
+		rent.RentDate = now
+		if err := s.transition(repos, rent, StatusOngoing, req.Reason, updatedBy); err != nil {
+			return err
+		}
+		if err := repos.Rent.CreateSegment(&RentSegment{
+			RentID:      rent.ID,
+			VehicleID:   vh.ID,
+			StartAt:     rent.RentDate,
+			Reason:      "pickup",
+			CreatedByID: updatedBy,
+		}); err != nil {
+			return errors.New("failed to record rent segment")
+		}
+
+		vh.Status = vehicle.StatusRented
+		if err := repos.Vehicle.Update(vh); err != nil {
+			return errors.New("failed to update vehicle status")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// CompleteRent implements Service.
+// Kendaraan dikembalikan: hitung tagihan final, cek saldo, terbitkan invoice.
+func (s *service) CompleteRent(id uint, req *CompleteRentRequest, updatedBy uint) (*RentResponse, error) {
+	var warnings []string
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if !rent.Status.CanTransitionTo(StatusCompleted) {
+			return fmt.Errorf("cannot complete %s rent", rent.Status)
+		}
+
+		// Set return date ke sekarang
+		now := time.Now()
+		rent.ReturnDate = &now
+
+		vh, err := s.lockRentVehicle(repos, rent)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Rent.CloseOpenSegment(rent.ID, now); err != nil {
+			return errors.New("failed to close rent segment")
+		}
+
+		// Branch pengembalian, default sesuai rencana drop-off
+		rent.ReturnBranchID = rent.DropoffBranchID
+		if req.ReturnBranchID != nil {
+			if _, err := s.branches.CheckActive(repos.Tx, *req.ReturnBranchID); err != nil {
+				return err
+			}
+			rent.ReturnBranchID = req.ReturnBranchID
+		}
+
+		// Hitung total price lewat pricing engine, simpan rinciannya
+		quote, err := s.rentalQuote(repos, rent, vh)
+		if err != nil {
+			return err
+		}
+		charges := toRentCharges(rent.ID, quote)
+
+		// Potongan promo dihitung dari harga sewa, sebelum denda
+		discount, err := s.promos.Apply(repos.Tx, rent.ID, quote.Total)
+		if err != nil {
+			return err
+		}
+		if discount != nil {
+			charges = append(charges, toRentCharge(rent.ID, discount))
+		}
+
+		// Extras dihitung sesuai lama sewa sebenarnya
+		extraLines, err := s.extras.Charges(repos.Tx, rent.ID, rent.RentDate, *rent.ReturnDate)
+		if err != nil {
+			return err
+		}
+		for i := range extraLines {
+			charges = append(charges, toRentCharge(rent.ID, &extraLines[i]))
+		}
+
+		// Biaya one-way dihitung dari branch pengembalian sebenarnya
+		if rent.PickupBranchID != nil && rent.ReturnBranchID != nil {
+			fee, err := s.branches.OneWayFeeFor(repos.Tx, *rent.PickupBranchID, *rent.ReturnBranchID)
+			if err != nil {
+				return err
+			}
+			if fee > 0 {
+				charges = append(charges, &RentCharge{
+					RentID:      rent.ID,
+					Type:        pricing.ChargeOneWayFee,
+					Description: "One-way drop-off fee",
+					Quantity:    1,
+					UnitPrice:   fee,
+					Amount:      fee,
+				})
+			}
+		}
+
+		// Denda keterlambatan jika kembali melewati expected return + grace period
+		if lateFee := calculateLateFee(rent, vh, s.lateGracePeriod(), s.lateFeePercent()); lateFee != nil {
+			charges = append(charges, lateFee)
+		}
+		if err := repos.Rent.CreateCharges(charges); err != nil {
+			return errors.New("failed to save rent charges")
+		}
+		if rent.TotalPrice, err = repos.Rent.SumCharges(rent.ID); err != nil {
+			return errors.New("failed to calculate total price")
+		}
+
+		// Cek saldo pembayaran terhadap tagihan final
+		received, err := s.payments.GetReceivedAmount(rent.ID)
+		if err != nil {
+			return err
+		}
+		if balance := rent.TotalPrice - received; balance > 0.005 {
+			if s.cfg.UnpaidCompletionPolicy == "block" {
+				return fmt.Errorf("rent has unpaid balance of %.2f", balance)
+			}
+			warnings = append(warnings, fmt.Sprintf("rent completed with unpaid balance of %.2f", balance))
+		}
+
+		if err := s.transition(repos, rent, StatusCompleted, req.Reason, updatedBy); err != nil {
+			return err
+		}
+
+		// Lokasi kendaraan pindah ke branch pengembalian
+		if rent.ReturnBranchID != nil && (vh.BranchID == nil || *vh.BranchID != *rent.ReturnBranchID) {
+			vh.BranchID = rent.ReturnBranchID
+			if err := repos.Vehicle.Update(vh); err != nil {
+				return errors.New("failed to update vehicle location")
+			}
+		}
+
+		// Update status kendaraan menjadi available, kecuali sudah
+		// dipindah ke maintenance (mis. lewat damage report)
+		return releaseVehicle(repos, vh)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Terbitkan invoice. Rent sudah tersimpan, jadi kegagalan di sini
+	// cukup jadi peringatan; invoice akan dibuat ulang saat diunduh.
+	if err := s.invoices.IssueInvoice(id); err != nil {
+		log.Printf("failed to issue invoice for rent %d: %v", id, err)
+		warnings = append(warnings, "failed to issue invoice")
+	}
+
+	resp, err := s.GetRentByID(id)
+	if err != nil {
+		return nil, err
+	}
+	resp.Warnings = warnings
+	return resp, nil
+}
+
+// CancelRent implements Service.
+func (s *service) CancelRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error) {
+	err := s.uow.Do(func(repos *Repositories) error {
+		return s.CancelRentTx(repos, id, req, updatedBy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// CancelRentTx implements Service.
+// Dipakai CancelRent dan pembatalan booking grup secara keseluruhan.
+func (s *service) CancelRentTx(repos *Repositories, id uint, req *TransitionRequest, updatedBy uint) error {
+	rent, err := repos.Rent.FindByIDForUpdate(id)
+	if err != nil {
+		return errors.New("rent not found")
+	}
+	if rent.Status == StatusOngoing {
+		return errors.New("rent is already picked up, complete it instead of cancelling")
+	}
+	if !rent.Status.CanTransitionTo(StatusCancelled) {
+		return fmt.Errorf("cannot cancel %s rent", rent.Status)
+	}
+
+	vh, err := s.lockRentVehicle(repos, rent)
+	if err != nil {
+		return err
+	}
+	if err := s.applyCancellationFee(repos, rent, vh, false); err != nil {
+		return err
+	}
+	if err := s.promos.Release(repos.Tx, rent.ID); err != nil {
+		return err
+	}
+	// Reservasi belum memegang kendaraan, status kendaraan tidak berubah
+	return s.transition(repos, rent, StatusCancelled, req.Reason, updatedBy)
+}
+
+// NoShowRent implements Service.
+func (s *service) NoShowRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error) {
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if !rent.Status.CanTransitionTo(StatusNoShow) {
+			return fmt.Errorf("cannot mark %s rent as no-show", rent.Status)
+		}
+		if time.Now().Before(rent.PlannedStartDate) {
+			return errors.New("cannot mark no-show before the planned pickup time")
+		}
+
+		vh, err := s.lockRentVehicle(repos, rent)
+		if err != nil {
+			return err
+		}
+		if err := s.applyCancellationFee(repos, rent, vh, true); err != nil {
+			return err
+		}
+		if err := s.promos.Release(repos.Tx, rent.ID); err != nil {
+			return err
+		}
+		return s.transition(repos, rent, StatusNoShow, req.Reason, updatedBy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// CloseRent implements Service.
+// Rent yang sudah dikembalikan ditutup setelah tagihannya lunas.
+func (s *service) CloseRent(id uint, req *TransitionRequest, updatedBy uint) (*RentResponse, error) {
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if !rent.Status.CanTransitionTo(StatusClosed) {
+			return fmt.Errorf("cannot close %s rent", rent.Status)
+		}
+
+		received, err := s.payments.GetReceivedAmount(rent.ID)
+		if err != nil {
+			return err
+		}
+		if balance := rent.TotalPrice - received; balance > 0.005 {
+			return fmt.Errorf("rent has unpaid balance of %.2f", balance)
+		}
+		return s.transition(repos, rent, StatusClosed, req.Reason, updatedBy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// ExtendRent implements Service.
+func (s *service) ExtendRent(id uint, req *ExtendRentRequest, updatedBy uint) (*ExtendRentResponse, error) {
+	var quote *pricing.Quote
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if rent.Status != StatusReserved && rent.Status != StatusOngoing {
+			return errors.New("only reserved or ongoing rent can be extended")
+		}
+		if rent.PlannedEndDate != nil && !req.EndDate.After(*rent.PlannedEndDate) {
+			return errors.New("new end_date must be after current expected return")
+		}
+
+		// Kunci class & kendaraan lalu cek bentrok dengan booking lain di periode baru
+		var vh *vehicle.Vehicle
+		var class *vehicleclass.Class
+		if rent.VehicleID != nil {
+			if vh, class, err = s.lockVehicle(repos, *rent.VehicleID); err != nil {
+				return err
+			}
+			overlap, err := repos.Rent.HasOverlap(vh.ID, rent.PlannedStartDate, &req.EndDate, rent.ID)
+			if err != nil {
+				return err
+			}
+			if overlap {
+				return errors.New("vehicle is already booked after the current expected return")
+			}
+			if err := s.maintenance.CheckWindow(repos.Tx, vh.ID, rent.PlannedStartDate, &req.EndDate); err != nil {
+				return err
+			}
+			// Dokumen harus tetap berlaku sampai expected return yang baru
+			if err := s.documents.CheckValid(repos.Tx, vh.ID, rent.PlannedStartDate, &req.EndDate); err != nil {
+				return fmt.Errorf("vehicle %s cannot be rented: %w", vh.PlateNumber, err)
+			}
+		} else {
+			if rent.ClassID == nil {
+				return errors.New("rent has no vehicle or vehicle class")
+			}
+			if class, err = s.classes.Lock(repos.Tx, *rent.ClassID); err != nil {
+				return err
+			}
+			vh = classVehicle(class)
+		}
+		if class != nil && vh.Status != vehicle.StatusMaintenance {
+			if err := s.checkClassCapacity(repos, class, rent.PlannedStartDate, req.EndDate, rent.ID); err != nil {
+				return err
+			}
+		}
+
+		// Extras harus tetap tersedia sampai expected return yang baru
+		if err := s.extras.CheckPeriod(repos.Tx, rent.ID, rent.PlannedStartDate, &req.EndDate); err != nil {
+			return err
+		}
+
+		// Proyeksi harga untuk periode yang baru, termasuk extras
+		quote, err = s.projectedQuote(repos, rent, vh, req.EndDate)
+		if err != nil {
+			return err
+		}
+
+		endDate := req.EndDate
+		rent.PlannedEndDate = &endDate
+		if req.Notes != nil {
+			rent.Notes = *req.Notes
+		}
+		rent.UpdatedByID = updatedBy
+		if err := repos.Rent.Update(rent); err != nil {
+			return errors.New("failed to update rent")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	extended, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendRentResponse{
+		Rent:           s.toResponse(extended),
+		ProjectedPrice: quote,
+	}, nil
+}
+
+// EstimateRent implements Service.
+// Proyeksi harga sampai expected return (sewa, promo, extras, one-way), dipakai
+// untuk dokumen sebelum rent di-complete.
+func (s *service) EstimateRent(id uint) (*pricing.Quote, error) {
+	var quote *pricing.Quote
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByID(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if rent.PlannedEndDate == nil {
+			return errors.New("rent has no expected return date")
+		}
+		vh := &rent.Vehicle
+		if rent.VehicleID == nil && rent.Class != nil {
+			vh = classVehicle(rent.Class)
+		}
+		quote, err = s.projectedQuote(repos, rent, vh, *rent.PlannedEndDate)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// SwapVehicle implements Service.
+// Mengganti kendaraan rent ongoing (mis. mogok). Segment kendaraan lama
+// ditutup, segment baru dibuka, dan harga final diprorata per kendaraan.
+func (s *service) SwapVehicle(id uint, req *SwapRentRequest, updatedBy uint) (*RentResponse, error) {
+	setMaintenance := true
+	if req.SetMaintenance != nil {
+		setMaintenance = *req.SetMaintenance
+	}
+
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if rent.Status != StatusOngoing {
+			return errors.New("only ongoing rent can swap vehicle")
+		}
+		if rent.VehicleID == nil {
+			return errors.New("rent has no vehicle to swap")
+		}
+		if *rent.VehicleID == req.VehicleID {
+			return errors.New("new vehicle must be different from the current vehicle")
+		}
+
+		// Class kendaraan baru dikunci lebih dulu, sama seperti saat booking
+		peek, err := repos.Vehicle.FindByID(req.VehicleID)
+		if err != nil {
+			return fmt.Errorf("vehicle %d not found", req.VehicleID)
+		}
+		var class *vehicleclass.Class
+		if peek.ClassID != nil {
+			if class, err = s.classes.Lock(repos.Tx, *peek.ClassID); err != nil {
+				return err
+			}
+		}
+
+		// Kunci kedua kendaraan berurutan ID supaya swap paralel tidak deadlock
+		first, second := *rent.VehicleID, req.VehicleID
+		if first > second {
+			first, second = second, first
+		}
+		locked := map[uint]*vehicle.Vehicle{}
+		for _, vid := range []uint{first, second} {
+			vh, err := repos.Vehicle.FindByIDForUpdate(vid)
+			if err != nil {
+				return fmt.Errorf("vehicle %d not found", vid)
+			}
+			locked[vid] = vh
+		}
+		oldVh, newVh := locked[*rent.VehicleID], locked[req.VehicleID]
+
+		now := time.Now()
+		if newVh.Status != vehicle.StatusAvailable {
+			return fmt.Errorf("vehicle %s is not available", newVh.PlateNumber)
+		}
+		overlap, err := repos.Rent.HasOverlap(newVh.ID, now, rent.PlannedEndDate, rent.ID)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return fmt.Errorf("vehicle %s is already booked for the rest of the rent", newVh.PlateNumber)
+		}
+		if err := s.maintenance.CheckWindow(repos.Tx, newVh.ID, now, rent.PlannedEndDate); err != nil {
+			return err
+		}
+		if err := s.documents.CheckValid(repos.Tx, newVh.ID, now, rent.PlannedEndDate); err != nil {
+			return fmt.Errorf("vehicle %s cannot be rented: %w", newVh.PlateNumber, err)
+		}
+		if class != nil && rent.PlannedEndDate != nil {
+			if err := s.checkClassCapacity(repos, class, now, *rent.PlannedEndDate, rent.ID); err != nil {
+				return err
+			}
+		}
+
+		// Rent lama tanpa segment: catat segment awal secara retroaktif
+		segments, err := repos.Rent.FindSegments(rent.ID)
+		if err != nil {
+			return err
+		}
+		// Inspeksi dicatat per rent + kendaraan, jadi kendaraan yang sudah
+		// pernah dipakai di rent ini tidak bisa dipakai lagi
+		for _, segment := range segments {
+			if segment.VehicleID == newVh.ID {
+				return fmt.Errorf("vehicle %s was already used earlier in this rent", newVh.PlateNumber)
+			}
+		}
+		if len(segments) == 0 {
+			if err := repos.Rent.CreateSegment(&RentSegment{
+				RentID:      rent.ID,
+				VehicleID:   oldVh.ID,
+				StartAt:     rent.RentDate,
+				Reason:      "pickup",
+				CreatedByID: rent.CreatedByID,
+			}); err != nil {
+				return errors.New("failed to record rent segment")
+			}
+		}
+		if err := repos.Rent.CloseOpenSegment(rent.ID, now); err != nil {
+			return errors.New("failed to close rent segment")
+		}
+		if err := repos.Rent.CreateSegment(&RentSegment{
+			RentID:      rent.ID,
+			VehicleID:   newVh.ID,
+			StartAt:     now,
+			Reason:      req.Reason,
+			CreatedByID: updatedBy,
+		}); err != nil {
+			return errors.New("failed to record rent segment")
+		}
+
+		if err := s.inspections.RecordSwap(repos.Tx, rent.ID, oldVh.ID, newVh.ID, &req.Checkin, &req.Checkout, updatedBy); err != nil {
+			return err
+		}
+
+		rent.VehicleID = &newVh.ID
+		rent.UpdatedByID = updatedBy
+		if err := repos.Rent.Update(rent); err != nil {
+			return errors.New("failed to update rent")
+		}
+
+		oldVh.Status = vehicle.StatusAvailable
+		if setMaintenance {
+			oldVh.Status = vehicle.StatusMaintenance
+		}
+		if err := repos.Vehicle.Update(oldVh); err != nil {
+			return errors.New("failed to update vehicle status")
+		}
+		newVh.Status = vehicle.StatusRented
+		if err := repos.Vehicle.Update(newVh); err != nil {
+			return errors.New("failed to update vehicle status")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// ClassAvailability implements Service.
+// Dipakai endpoint availability vehicle class, tanpa mengunci baris apa pun.
+func (s *service) ClassAvailability(classID uint, from, to time.Time) (*vehicleclass.Availability, error) {
+	if !to.After(from) {
+		return nil, errors.New("to must be after from")
+	}
+	class, err := s.classes.GetClassByID(classID)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, used int
+	err = s.uow.Do(func(repos *Repositories) error {
+		var err error
+		total, used, err = s.classUsage(repos, class.ID, from, to, 0)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	available := total - used
+	if available < 0 || !class.Active {
+		available = 0
+	}
+	return &vehicleclass.Availability{
+		Class:     class,
+		From:      clock.Format(from),
+		To:        clock.Format(to),
+		Total:     total,
+		Reserved:  used,
+		Available: available,
+	}, nil
+}
+
+// ClassFreeCount implements Service.
+// Sisa kendaraan class yang bisa disewa selama [from, to), dipakai daftar
+// kendaraan available (vehicle.ClassCapacity).
+func (s *service) ClassFreeCount(classID uint, from, to time.Time) (int, error) {
+	var free int
+	err := s.uow.Do(func(repos *Repositories) error {
+		total, used, err := s.classUsage(repos, classID, from, to, 0)
+		free = max(total-used, 0)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return free, nil
+}
+
+// ClassFreeCountTx implements Service.
+// Seperti ClassFreeCount tapi di dalam transaksi pemanggil yang sudah
+// mengunci class (maintenance.ClassCapacity). Bisa negatif jika class
+// sudah overbooked.
+func (s *service) ClassFreeCountTx(tx *gorm.DB, classID uint, from, to time.Time) (int, error) {
+	repos := &Repositories{
+		Rent:    s.repo.WithTx(tx),
+		Vehicle: s.vehicleRepo.WithTx(tx),
+		Tx:      tx,
+	}
+	total, used, err := s.classUsage(repos, classID, from, to, 0)
+	if err != nil {
+		return 0, err
+	}
+	return total - used, nil
+}
+
+// AddDriver implements Service.
+func (s *service) AddDriver(id uint, req *DriverRequest, updatedBy uint) (*RentResponse, error) {
+	if (req.CustomerID == nil) == (req.Customer == nil) {
+		return nil, errors.New("exactly one of customer_id or customer is required")
+	}
+
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if rent.Status != StatusReserved && rent.Status != StatusOngoing {
+			return errors.New("drivers can only be changed on reserved or ongoing rent")
+		}
+
+		customerID := uint(0)
+		if req.CustomerID != nil {
+			customerID = *req.CustomerID
+		} else {
+			// Pengemudi baru didaftarkan sebagai customer
+			if err := customer.ValidateIDCard(req.Customer.IDCard); err != nil {
+				return fmt.Errorf("driver %s: %w", req.Customer.Name, err)
+			}
+			driver := &customer.Customer{
+				Name:    req.Customer.Name,
+				Phone:   req.Customer.Phone,
+				Email:   req.Customer.Email,
+				Address: req.Customer.Address,
+				IDCard:  req.Customer.IDCard,
+			}
+			if err := repos.Customer.Create(driver); err != nil {
+				return fmt.Errorf("failed to create driver: %w", err)
+			}
+			customerID = driver.ID
+		}
+
+		if err := s.addDriver(repos, rent, customerID, updatedBy); err != nil {
+			return err
+		}
+		rent.UpdatedByID = updatedBy
+		return repos.Rent.Update(rent)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
 }
 
+// RemoveDriver implements Service.
+func (s *service) RemoveDriver(id uint, customerID uint, updatedBy uint) (*RentResponse, error) {
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+		if rent.Status != StatusReserved && rent.Status != StatusOngoing {
+			return errors.New("drivers can only be changed on reserved or ongoing rent")
+		}
+
+		removed, err := repos.Rent.RemoveDriver(rent.ID, customerID)
+		if err != nil {
+			return errors.New("failed to remove driver")
+		}
+		if !removed {
+			return errors.New("customer is not a driver on this rent")
+		}
+		rent.UpdatedByID = updatedBy
+		return repos.Rent.Update(rent)
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	return s.GetRentByID(id)
+}
 
 // GetAllRents implements Service.
-func (s *service) GetAllRents() ([]*RentResponse, error) {
-	rents, err := s.repo.FindAll()
+func (s *service) GetAllRents(filter *RentFilter) (*RentListResponse, error) {
+	// Default: terbaru dulu, 20 per halaman
+	if filter.Sort == "" {
+		filter.Sort = "id"
+	}
+	if filter.Order == "" {
+		filter.Order = "desc"
+	}
+	if filter.Limit == 0 {
+		filter.Limit = 20
+	}
+
+	var after *PageCursor
+	if filter.Cursor != "" {
+		var err error
+		if after, err = decodeCursor(filter.Cursor, filter.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	rents, err := s.repo.FindAll(filter, after)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RentListResponse{Items: []*RentResponse{}}
+	if len(rents) > filter.Limit {
+		rents = rents[:filter.Limit]
+		result.NextCursor = encodeCursor(rents[len(rents)-1], filter.Sort)
+	}
+	for _, rent := range rents {
+		result.Items = append(result.Items, s.toResponse(rent))
+	}
+	return result, nil
+}
+
+// GetOverdueRents implements Service.
+func (s *service) GetOverdueRents() ([]*RentResponse, error) {
+	rents, err := s.repo.FindOverdue(time.Now().Add(-s.lateGracePeriod()))
 	if err != nil {
 		return nil, err
 	}
 	var responses []*RentResponse
 	for _, rent := range rents {
-		responses = append(responses, ToRentResponse(rent))
+		responses = append(responses, s.toResponse(rent))
 	}
 	return responses, nil
 }
@@ -81,101 +1027,411 @@ func (s *service) GetRentByID(id uint) (*RentResponse, error) {
 	if err != nil {
 		return nil, err
 	}	
-	return ToRentResponse(rent), nil
+	return s.toResponse(rent), nil
 }
 
 // UpdateRent implements Service.
 func (s *service) UpdateRent(id uint, req *UpdateRentRequest, updatedBy uint) (*RentResponse, error) {
-    rent, err := s.repo.FindByID(id)
-    if err != nil {
-        return nil, errors.New("rent not found")
-    }
-
-    // Update UpdatedByID
-    rent.UpdatedByID = updatedBy    // Update Notes
-    if req.Notes != nil {
-        rent.Notes = *req.Notes
-    }
-
-    // Update Status
-    if req.Status != nil {
-        oldStatus := rent.Status
-        newStatus := RentStatus(*req.Status)
-
-        // Validasi status transition
-        if newStatus != StatusOngoing && newStatus != StatusCompleted && newStatus != StatusCancelled {
-            return nil, errors.New("invalid status value")
-        }
-
-        // Validasi: tidak bisa complete/cancel jika sudah complete
-        if oldStatus == StatusCompleted && newStatus != StatusCompleted {
-            return nil, errors.New("cannot change status from completed")
-        }
-
-        // Validasi: tidak bisa complete/cancel jika sudah cancelled
-        if oldStatus == StatusCancelled && newStatus != StatusCancelled {
-            return nil, errors.New("cannot change status from cancelled")
-        }
-
-        rent.Status = newStatus
-
-        // Jika status berubah menjadi completed
-        if newStatus == StatusCompleted && oldStatus != StatusCompleted {
-            // Set return date ke sekarang
-            now := time.Now()
-            rent.ReturnDate = &now
-
-            // Hitung total price
-            vh, err := s.vehicleRepo.FindByID(rent.VehicleID)
-            if err != nil {
-                return nil, errors.New("vehicle not found")
-            }
-
-            // Hitung jumlah hari
-            days := int(rent.ReturnDate.Sub(rent.RentDate).Hours()/24) + 1
-            if days < 1 {
-                days = 1
-            }
-            rent.TotalPrice = float64(days) * vh.PricePerDay
-
-            // Update status kendaraan menjadi available
-            vh.Status = vehicle.StatusAvailable
-            if err := s.vehicleRepo.Update(vh); err != nil {
-                return nil, errors.New("failed to update vehicle status")
-            }
-        }
-
-        // Jika status berubah menjadi cancelled
-        if newStatus == StatusCancelled && oldStatus != StatusCancelled {
-            // Update status kendaraan menjadi available
-            vh, err := s.vehicleRepo.FindByID(rent.VehicleID)
-            if err != nil {
-                return nil, errors.New("vehicle not found")
-            }
-            vh.Status = vehicle.StatusAvailable
-            if err := s.vehicleRepo.Update(vh); err != nil {
-                return nil, errors.New("failed to update vehicle status")
-            }
-        }
-    }
-
-    // Save to DB
-    if err := s.repo.Update(rent); err != nil {
-        return nil, errors.New("failed to update rent")
-    }
-
-    // Reload relasi agar response lengkap
-    updatedRent, err := s.repo.FindByID(rent.ID)
-    if err != nil {
-        return nil, err
-    }
-    return ToRentResponse(updatedRent), nil
-}
-
-func NewService(repo Repository, vehicleRepo vehicle.Repository, cfg config.Config) Service {
-    return &service{
-        repo:        repo,
-        vehicleRepo: vehicleRepo,
-        cfg:         cfg,
-    }
-}
\ No newline at end of file
+	err := s.uow.Do(func(repos *Repositories) error {
+		rent, err := repos.Rent.FindByIDForUpdate(id)
+		if err != nil {
+			return errors.New("rent not found")
+		}
+
+		// Update UpdatedByID
+		rent.UpdatedByID = updatedBy
+		// Update Notes
+		if req.Notes != nil {
+			rent.Notes = *req.Notes
+		}
+
+		// Save to DB
+		if err := repos.Rent.Update(rent); err != nil {
+			return errors.New("failed to update rent")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRentByID(id)
+}
+
+// projectedQuote memproyeksikan harga sewa dari RentDate sampai end dengan
+// urutan yang sama seperti CompleteRent: sewa, potongan promo, extras, lalu
+// biaya one-way
+func (s *service) projectedQuote(repos *Repositories, rent *Rent, vh *vehicle.Vehicle, end time.Time) (*pricing.Quote, error) {
+	quote, err := s.pricing.Calculate(vh, rent.RentDate, end)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := s.promos.Estimate(repos.Tx, rent.ID, quote.Total)
+	if err != nil {
+		return nil, err
+	}
+	if discount != nil {
+		quote.Items = append(quote.Items, *discount)
+		quote.Total += discount.Amount
+	}
+	extraLines, err := s.extras.Charges(repos.Tx, rent.ID, rent.RentDate, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range extraLines {
+		quote.Items = append(quote.Items, line)
+		quote.Total += line.Amount
+	}
+	// Biaya one-way sesuai branch drop-off yang direncanakan
+	if rent.OneWayFee > 0 {
+		quote.Items = append(quote.Items, pricing.LineItem{
+			Type:        pricing.ChargeOneWayFee,
+			Description: "One-way drop-off fee",
+			Quantity:    1,
+			UnitPrice:   rent.OneWayFee,
+			Amount:      rent.OneWayFee,
+		})
+		quote.Total += rent.OneWayFee
+	}
+	return quote, nil
+}
+
+// rentalQuote menghitung harga sewa dari RentDate sampai ReturnDate. Jika
+// kendaraan pernah ditukar, harga penuh tiap kendaraan diprorata sesuai porsi
+// waktu pemakaiannya, sehingga pembulatan hari tidak dihitung dua kali.
+func (s *service) rentalQuote(repos *Repositories, rent *Rent, current *vehicle.Vehicle) (*pricing.Quote, error) {
+	segments, err := repos.Rent.FindSegments(rent.ID)
+	if err != nil {
+		return nil, err
+	}
+	total := rent.ReturnDate.Sub(rent.RentDate)
+	if len(segments) <= 1 || total <= 0 {
+		return s.pricing.Calculate(current, rent.RentDate, *rent.ReturnDate)
+	}
+
+	quote := &pricing.Quote{}
+	for _, seg := range segments {
+		end := *rent.ReturnDate
+		if seg.EndAt != nil {
+			end = *seg.EndAt
+		}
+		vh, err := repos.Vehicle.FindByID(seg.VehicleID)
+		if err != nil {
+			return nil, fmt.Errorf("vehicle %d not found", seg.VehicleID)
+		}
+		full, err := s.pricing.Calculate(vh, rent.RentDate, *rent.ReturnDate)
+		if err != nil {
+			return nil, err
+		}
+		share := float64(end.Sub(seg.StartAt)) / float64(total)
+		for _, item := range prorateItems(full.Items, share, vh.PlateNumber) {
+			quote.Items = append(quote.Items, item)
+			quote.Total += item.Amount
+		}
+	}
+	quote.Total = math.Round(quote.Total*100) / 100
+	return quote, nil
+}
+
+// transition memindahkan status rent sesuai tabel transitions, menyimpan
+// rent, dan mencatat riwayatnya. Harus dipanggil di dalam uow.Do.
+func (s *service) transition(repos *Repositories, rent *Rent, to RentStatus, reason string, changedBy uint) error {
+	from := rent.Status
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("invalid status transition from %s to %s", from, to)
+	}
+
+	rent.Status = to
+	rent.UpdatedByID = changedBy
+	if err := repos.Rent.Update(rent); err != nil {
+		return errors.New("failed to update rent")
+	}
+
+	history := &RentStatusHistory{
+		RentID:      rent.ID,
+		FromStatus:  from,
+		ToStatus:    to,
+		Reason:      reason,
+		ChangedByID: changedBy,
+		ChangedAt:   time.Now(),
+	}
+	if err := repos.Rent.CreateHistory(history); err != nil {
+		return errors.New("failed to record rent history")
+	}
+	return nil
+}
+
+// applyCancellationFee menulis biaya pembatalan / no-show sesuai policy
+// tipe kendaraan ke rent charges dan memperbarui TotalPrice
+func (s *service) applyCancellationFee(repos *Repositories, rent *Rent, vh *vehicle.Vehicle, noShow bool) error {
+	if rent.PlannedEndDate == nil {
+		return nil
+	}
+	fee, err := s.pricing.CancellationFee(vh, rent.PlannedStartDate, *rent.PlannedEndDate, time.Now(), noShow)
+	if err != nil {
+		return err
+	}
+	if fee == nil {
+		return nil
+	}
+
+	if err := repos.Rent.CreateCharges([]*RentCharge{toRentCharge(rent.ID, fee)}); err != nil {
+		return errors.New("failed to save rent charges")
+	}
+	if rent.TotalPrice, err = repos.Rent.SumCharges(rent.ID); err != nil {
+		return errors.New("failed to calculate total price")
+	}
+	return nil
+}
+
+// addDriver mendaftarkan customer sebagai pengemudi tambahan setelah
+// memastikan KTP-nya valid. Harus dipanggil di dalam uow.Do.
+func (s *service) addDriver(repos *Repositories, rent *Rent, customerID uint, addedBy uint) error {
+	if customerID == rent.CustomerID {
+		return errors.New("renter is already the main driver")
+	}
+	driver, err := repos.Customer.FindByID(customerID)
+	if err != nil {
+		return fmt.Errorf("driver %d not found", customerID)
+	}
+	if err := customer.ValidateIDCard(driver.IDCard); err != nil {
+		return fmt.Errorf("driver %s: %w", driver.Name, err)
+	}
+
+	if err := repos.Rent.AddDriver(&RentDriver{
+		RentID:     rent.ID,
+		CustomerID: driver.ID,
+		AddedByID:  addedBy,
+	}); err != nil {
+		return fmt.Errorf("driver %s is already added to this rent", driver.Name)
+	}
+	return nil
+}
+
+// lockVehicle mengunci class kendaraan (jika ada) lalu baris kendaraannya.
+// Urutan class -> kendaraan sama dengan reservasi per class, sehingga cek
+// kapasitas class dan booking kendaraan tertentu di class itu saling antre.
+func (s *service) lockVehicle(repos *Repositories, id uint) (*vehicle.Vehicle, *vehicleclass.Class, error) {
+	peek, err := repos.Vehicle.FindByID(id)
+	if err != nil {
+		return nil, nil, errors.New("vehicle not found")
+	}
+	var class *vehicleclass.Class
+	if peek.ClassID != nil {
+		if class, err = s.classes.Lock(repos.Tx, *peek.ClassID); err != nil {
+			return nil, nil, err
+		}
+	}
+	vh, err := repos.Vehicle.FindByIDForUpdate(id)
+	if err != nil {
+		return nil, nil, errors.New("vehicle not found")
+	}
+	return vh, class, nil
+}
+
+// lockRentVehicle mengunci kendaraan rent. Reservasi class yang belum
+// di-assign tidak punya kendaraan, jadi dipakai kendaraan pengganti dari
+// class-nya yang cukup untuk menghitung biaya.
+func (s *service) lockRentVehicle(repos *Repositories, rent *Rent) (*vehicle.Vehicle, error) {
+	if rent.VehicleID != nil {
+		vh, err := repos.Vehicle.FindByIDForUpdate(*rent.VehicleID)
+		if err != nil {
+			return nil, errors.New("vehicle not found")
+		}
+		return vh, nil
+	}
+	if rent.ClassID == nil {
+		return nil, errors.New("rent has no vehicle or vehicle class")
+	}
+	class, err := s.classes.GetClassByID(*rent.ClassID)
+	if err != nil {
+		return nil, err
+	}
+	return classVehicle(class), nil
+}
+
+// assignClassVehicle memilih lalu mengunci kendaraan class yang available,
+// berada di branch pickup (jika ada) dan kosong selama [start, end).
+// Kendaraan dengan dokumen wajib kedaluwarsa dilewati. Baris class harus
+// sudah dikunci supaya dua pickup tidak memilih kendaraan yang sama.
+func (s *service) assignClassVehicle(repos *Repositories, class *vehicleclass.Class, branchID *uint, start, end time.Time) (*vehicle.Vehicle, error) {
+	status := string(vehicle.StatusAvailable)
+	candidates, err := repos.Vehicle.FindAvailable(&vehicle.AvailabilityFilter{
+		VehicleFilter: vehicle.VehicleFilter{
+			Status:   &status,
+			ClassID:  &class.ID,
+			BranchID: branchID,
+		},
+		From: start,
+		To:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		vh, err := repos.Vehicle.FindByIDForUpdate(candidate.ID)
+		if err != nil || vh.Status != vehicle.StatusAvailable {
+			continue
+		}
+		if err := s.documents.CheckValid(repos.Tx, vh.ID, start, &end); err != nil {
+			continue
+		}
+		return vh, nil
+	}
+	return nil, fmt.Errorf("no %s vehicle is ready for pickup", class.Name)
+}
+
+// checkClassCapacity menolak rent baru / perpanjangan jika class sudah
+// penuh di salah satu titik dalam [start, end). Baris class harus sudah dikunci.
+func (s *service) checkClassCapacity(repos *Repositories, class *vehicleclass.Class, start, end time.Time, excludeID uint) error {
+	total, used, err := s.classUsage(repos, class.ID, start, end, excludeID)
+	if err != nil {
+		return err
+	}
+	if used+1 > total {
+		return fmt.Errorf("vehicle class %s is fully booked for the requested period", class.Name)
+	}
+	return nil
+}
+
+// classUsage menghitung kendaraan class yang bisa dipakai (tidak sedang
+// maintenance) dan pemakaian tersibuknya selama [start, end): rent pada
+// kendaraan class, reservasi class yang belum di-assign dan jadwal servis.
+func (s *service) classUsage(repos *Repositories, classID uint, start, end time.Time, excludeID uint) (int, int, error) {
+	vehicles, err := repos.Vehicle.FindAll(&vehicle.VehicleFilter{ClassID: &classID})
+	if err != nil {
+		return 0, 0, err
+	}
+	var vehicleIDs []uint
+	for _, vh := range vehicles {
+		if vh.Status != vehicle.StatusMaintenance {
+			vehicleIDs = append(vehicleIDs, vh.ID)
+		}
+	}
+
+	rents, err := repos.Rent.FindClassRents(classID, vehicleIDs, start, end, excludeID)
+	if err != nil {
+		return 0, 0, err
+	}
+	orders, err := s.maintenance.FindWindows(repos.Tx, vehicleIDs, start, end)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	windows := make([]usageWindow, 0, len(rents)+len(orders))
+	for _, rt := range rents {
+		windowStart := rt.PlannedStartDate
+		if windowStart.IsZero() {
+			windowStart = rt.RentDate
+		}
+		windows = append(windows, usageWindow{Start: windowStart, End: rt.PlannedEndDate})
+	}
+	for _, order := range orders {
+		windowEnd := order.ScheduledEnd
+		windows = append(windows, usageWindow{Start: order.ScheduledStart, End: &windowEnd})
+	}
+	return len(vehicleIDs), peakUsage(windows, start, end), nil
+}
+
+// resolveBranches menentukan branch pickup & drop-off rent baru beserta
+// estimasi biaya one-way. Kendaraan tanpa lokasi boleh tanpa branch.
+// vh nil untuk reservasi class, branch pickup harus dari request.
+func (s *service) resolveBranches(repos *Repositories, req *RentRequest, vh *vehicle.Vehicle, reservation bool) (*uint, *uint, float64, error) {
+	pickupID := req.PickupBranchID
+	if pickupID == nil && vh != nil {
+		pickupID = vh.BranchID
+	}
+	dropoffID := req.DropoffBranchID
+	if dropoffID == nil {
+		dropoffID = pickupID
+	}
+	if pickupID == nil {
+		if dropoffID != nil {
+			return nil, nil, 0, errors.New("pickup_branch_id is required for one-way rent")
+		}
+		return nil, nil, 0, nil
+	}
+
+	if _, err := s.branches.CheckActive(repos.Tx, *pickupID); err != nil {
+		return nil, nil, 0, err
+	}
+	if *dropoffID != *pickupID {
+		if _, err := s.branches.CheckActive(repos.Tx, *dropoffID); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	// Walk-in langsung diserahkan, kendaraan harus ada di branch pickup.
+	// Reservasi dicek saat pickup karena lokasi bisa berubah sebelumnya.
+	if !reservation && vh != nil && vh.BranchID != nil && *vh.BranchID != *pickupID {
+		return nil, nil, 0, fmt.Errorf("vehicle %s is not at the pickup branch", vh.PlateNumber)
+	}
+
+	fee, err := s.branches.OneWayFeeFor(repos.Tx, *pickupID, *dropoffID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return pickupID, dropoffID, fee, nil
+}
+
+// releaseVehicle mengembalikan kendaraan ke available, kecuali sudah
+// dipindah ke status lain (mis. maintenance lewat damage report)
+func releaseVehicle(repos *Repositories, vh *vehicle.Vehicle) error {
+	if vh.Status != vehicle.StatusRented {
+		return nil
+	}
+	vh.Status = vehicle.StatusAvailable
+	if err := repos.Vehicle.Update(vh); err != nil {
+		return errors.New("failed to update vehicle status")
+	}
+	return nil
+}
+
+// ToResponse implements Service.
+// Sama seperti ToRentResponse ditambah status overdue, dipakai package lain
+// yang menampilkan rent (mis. booking).
+func (s *service) ToResponse(rent *Rent) *RentResponse {
+	return s.toResponse(rent)
+}
+
+// toResponse membungkus ToRentResponse dan menandai rent yang overdue
+func (s *service) toResponse(rent *Rent) *RentResponse {
+	resp := ToRentResponse(rent)
+	resp.Overdue = isOverdue(rent, time.Now(), s.lateGracePeriod())
+	return resp
+}
+
+func (s *service) lateGracePeriod() time.Duration {
+	grace, err := time.ParseDuration(s.cfg.LateGracePeriod)
+	if err != nil {
+		grace = time.Hour // default 1 jam
+	}
+	return grace
+}
+
+func (s *service) lateFeePercent() float64 {
+	percent, err := strconv.ParseFloat(s.cfg.LateFeePercent, 64)
+	if err != nil {
+		percent = 50 // default 50% tarif harian
+	}
+	return percent
+}
+
+func NewService(repo Repository, vehicleRepo vehicle.Repository, uow UnitOfWork, pricingService pricing.Service, promoService promo.Service, extraService extra.Service, maintenanceService maintenance.Service, documentService document.Service, branchService branch.Service, classService vehicleclass.Service, inspectionService inspection.Service, payments PaymentLedger, invoices InvoiceIssuer, cfg config.Config) Service {
+	return &service{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+		uow:         uow,
+		pricing:     pricingService,
+		promos:      promoService,
+		extras:      extraService,
+		maintenance: maintenanceService,
+		documents:   documentService,
+		branches:    branchService,
+		classes:     classService,
+		inspections: inspectionService,
+		payments:    payments,
+		invoices:    invoices,
+		cfg:         cfg,
+	}
+}
@@ -0,0 +1,63 @@
+package rent
+
+import (
+	"go-rental/internal/customer"
+	"go-rental/internal/vehicle"
+
+	"gorm.io/gorm"
+)
+
+// Repositories berisi repository yang sudah terikat ke satu transaksi.
+// Tx disediakan agar repository package lain bisa ikut transaksi lewat WithTx.
+type Repositories struct {
+	Rent     Repository
+	Vehicle  vehicle.Repository
+	Customer customer.Repository
+	Tx       *gorm.DB
+}
+
+// UnitOfWork menjalankan operasi rent, vehicle dan customer dalam satu
+// transaksi database. Jika fn mengembalikan error, semua perubahan di-rollback.
+type UnitOfWork interface {
+	Do(fn func(repos *Repositories) error) error
+}
+
+// PaymentLedger diimplementasikan oleh payment.Service. Didefinisikan di sini
+// agar package rent tidak perlu import package payment (import cycle).
+type PaymentLedger interface {
+	GetReceivedAmount(rentID uint) (float64, error)
+}
+
+// InvoiceIssuer diimplementasikan oleh invoice.Service, dipanggil setelah
+// rent berhasil di-complete.
+type InvoiceIssuer interface {
+	IssueInvoice(rentID uint) error
+}
+
+type unitOfWork struct {
+	db           *gorm.DB
+	rentRepo     Repository
+	vehicleRepo  vehicle.Repository
+	customerRepo customer.Repository
+}
+
+// Do implements UnitOfWork.
+func (u *unitOfWork) Do(fn func(repos *Repositories) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&Repositories{
+			Rent:     u.rentRepo.WithTx(tx),
+			Vehicle:  u.vehicleRepo.WithTx(tx),
+			Customer: u.customerRepo.WithTx(tx),
+			Tx:       tx,
+		})
+	})
+}
+
+func NewUnitOfWork(db *gorm.DB, rentRepo Repository, vehicleRepo vehicle.Repository, customerRepo customer.Repository) UnitOfWork {
+	return &unitOfWork{
+		db:           db,
+		rentRepo:     rentRepo,
+		vehicleRepo:  vehicleRepo,
+		customerRepo: customerRepo,
+	}
+}
@@ -0,0 +1,490 @@
+package rent_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go-rental/internal/branch"
+	"go-rental/internal/customer"
+	"go-rental/internal/document"
+	"go-rental/internal/extra"
+	"go-rental/internal/inspection"
+	"go-rental/internal/maintenance"
+	"go-rental/internal/pricing"
+	"go-rental/internal/promo"
+	"go-rental/internal/rent"
+	"go-rental/internal/user"
+	"go-rental/internal/vehicle"
+	"go-rental/internal/vehicleclass"
+	"go-rental/pkg/config"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Test integrasi butuh database MySQL kosong khusus test, contoh:
+//
+//	TEST_MYSQL_DSN="root:secret@tcp(localhost:3306)/go_rental_test?charset=utf8mb4&parseTime=True&loc=UTC" go test ./internal/rent/
+//
+// Tanpa TEST_MYSQL_DSN test dilewati.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN not set, skipping MySQL integration test")
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger:  logger.Default.LogMode(logger.Silent),
+		NowFunc: func() time.Time { return time.Now().UTC() },
+	})
+	if err != nil {
+		t.Fatalf("connect test database: %v", err)
+	}
+	err = db.AutoMigrate(
+		&user.User{},
+		&branch.Branch{},
+		&branch.OneWayFee{},
+		&vehicleclass.Class{},
+		&vehicle.Vehicle{},
+		&customer.Customer{},
+		&promo.Promo{},
+		&rent.Rent{},
+		&rent.RentCharge{},
+		&rent.RentStatusHistory{},
+		&rent.RentDriver{},
+		&rent.RentSegment{},
+		&promo.Redemption{},
+		&extra.Extra{},
+		&extra.RentExtra{},
+		&inspection.Inspection{},
+		&inspection.InspectionPhoto{},
+		&maintenance.Plan{},
+		&maintenance.WorkOrder{},
+		&document.Document{},
+	)
+	if err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+	return db
+}
+
+// fixture membuat staff, customer dan satu kendaraan available dengan data unik per test
+type fixture struct {
+	staff    *user.User
+	customer *customer.Customer
+	vehicle  *vehicle.Vehicle
+}
+
+func newFixture(t *testing.T, db *gorm.DB) *fixture {
+	t.Helper()
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	f := &fixture{
+		staff: &user.User{Name: "Test Staff", Phone: "+1" + suffix[len(suffix)-12:], Username: "staff" + suffix},
+		customer: &customer.Customer{
+			Name:   "Test Customer",
+			Phone:  suffix[len(suffix)-15:],
+			Email:  suffix + "@example.com",
+			IDCard: suffix,
+		},
+		vehicle: &vehicle.Vehicle{
+			Type:        vehicle.VehicleCar,
+			PlateNumber: "T" + suffix[len(suffix)-12:],
+			Brand:       "Toyota",
+			Model:       "Avanza",
+			Year:        2024,
+			PricePerDay: 300000,
+			Status:      vehicle.StatusAvailable,
+		},
+	}
+	for _, row := range []interface{}{f.staff, f.customer, f.vehicle} {
+		if err := db.Create(row).Error; err != nil {
+			t.Fatalf("create fixture: %v", err)
+		}
+	}
+
+	t.Cleanup(func() {
+		rentIDs := db.Model(&rent.Rent{}).Select("id").Where("customer_id = ?", f.customer.ID)
+		db.Where("rent_id IN (?)", rentIDs).Delete(&rent.RentSegment{})
+		db.Where("rent_id IN (?)", rentIDs).Delete(&rent.RentCharge{})
+		db.Where("rent_id IN (?)", rentIDs).Delete(&promo.Redemption{})
+		db.Where("rent_id IN (?)", rentIDs).Delete(&rent.RentStatusHistory{})
+		db.Where("customer_id = ?", f.customer.ID).Delete(&rent.Rent{})
+		db.Unscoped().Delete(f.vehicle)
+		db.Delete(f.customer)
+		db.Delete(f.staff)
+	})
+	return f
+}
+
+// newPromo membuat promo diskon 10% yang berlaku seminggu ke depan. Dipanggil
+// sebelum newFixture supaya promo baru dihapus setelah rent yang memakainya.
+func newPromo(t *testing.T, db *gorm.DB, usageLimit, perCustomerLimit int) *promo.Promo {
+	t.Helper()
+	now := time.Now()
+	p := &promo.Promo{
+		Code:             fmt.Sprintf("TEST%d", now.UnixNano()),
+		DiscountType:     promo.DiscountPercent,
+		DiscountValue:    10,
+		ValidFrom:        now.Add(-time.Hour),
+		ValidUntil:       now.Add(7 * 24 * time.Hour),
+		UsageLimit:       usageLimit,
+		PerCustomerLimit: perCustomerLimit,
+		Active:           true,
+	}
+	if err := db.Create(p).Error; err != nil {
+		t.Fatalf("create promo: %v", err)
+	}
+	t.Cleanup(func() { db.Delete(p) })
+	return p
+}
+
+// newRentService merakit rent.Service dengan dependency asli di atas db.
+// vehicleRepo dipakai unit of work, sehingga test bisa menyisipkan kegagalan.
+func newRentService(db *gorm.DB, vehicleRepo vehicle.Repository) rent.Service {
+	cfg := &config.Config{LateGracePeriod: "1h", LateFeePercent: "50", UnpaidCompletionPolicy: "warn"}
+	customerRepo := customer.NewRepository(db)
+	rentRepo := rent.NewRepository(db, user.NewRepository(db), vehicleRepo, customerRepo)
+	uow := rent.NewUnitOfWork(db, rentRepo, vehicleRepo, customerRepo)
+
+	return rent.NewService(
+		rentRepo,
+		vehicleRepo,
+		uow,
+		pricing.NewService(pricing.NewRepository(db), vehicleRepo, cfg),
+		promo.NewService(promo.NewRepository(db)),
+		extra.NewService(extra.NewRepository(db)),
+		maintenance.NewService(maintenance.NewRepository(db), vehicleRepo, vehicleclass.NewService(vehicleclass.NewRepository(db)), cfg),
+		document.NewService(document.NewRepository(db), vehicleRepo, cfg),
+		branch.NewService(branch.NewRepository(db)),
+		vehicleclass.NewService(vehicleclass.NewRepository(db)),
+		inspection.NewService(inspection.NewRepository(db), cfg),
+		noPayments{},
+		noInvoices{},
+		*cfg,
+	)
+}
+
+// noPayments & noInvoices menggantikan package payment dan invoice:
+// rent belum dibayar dan invoice tidak diterbitkan
+type noPayments struct{}
+
+func (noPayments) GetReceivedAmount(uint) (float64, error) { return 0, nil }
+
+type noInvoices struct{}
+
+func (noInvoices) IssueInvoice(uint) error { return nil }
+
+func TestCreateRentConcurrentSameVehicle(t *testing.T) {
+	db := openTestDB(t)
+	f := newFixture(t, db)
+	svc := newRentService(db, vehicle.NewRepository(db))
+
+	const workers = 8
+	end := time.Now().Add(48 * time.Hour)
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes int
+		failures  []error
+	)
+	start := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := svc.CreateRent(&rent.RentRequest{
+				CustomerID: f.customer.ID,
+				VehicleID:  &f.vehicle.ID,
+				EndDate:    &end,
+			}, f.staff.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, err)
+				return
+			}
+			successes++
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if successes != 1 {
+		t.Fatalf("expected exactly 1 successful rent, got %d (errors: %v)", successes, failures)
+	}
+
+	var vh vehicle.Vehicle
+	if err := db.First(&vh, f.vehicle.ID).Error; err != nil {
+		t.Fatalf("reload vehicle: %v", err)
+	}
+	if vh.Status != vehicle.StatusRented {
+		t.Errorf("vehicle status = %s, want %s", vh.Status, vehicle.StatusRented)
+	}
+
+	var ongoing int64
+	db.Model(&rent.Rent{}).Where("vehicle_id = ? AND status = ?", f.vehicle.ID, rent.StatusOngoing).Count(&ongoing)
+	if ongoing != 1 {
+		t.Errorf("ongoing rents for vehicle = %d, want 1", ongoing)
+	}
+}
+
+// failingVehicleRepo memaksa update kendaraan gagal, termasuk di dalam transaksi
+type failingVehicleRepo struct {
+	vehicle.Repository
+}
+
+func (r failingVehicleRepo) Update(*vehicle.Vehicle) error {
+	return errors.New("forced vehicle update failure")
+}
+
+func (r failingVehicleRepo) WithTx(tx *gorm.DB) vehicle.Repository {
+	return failingVehicleRepo{r.Repository.WithTx(tx)}
+}
+
+func TestCreateRentRollsBackWhenVehicleUpdateFails(t *testing.T) {
+	db := openTestDB(t)
+	f := newFixture(t, db)
+	svc := newRentService(db, failingVehicleRepo{vehicle.NewRepository(db)})
+
+	end := time.Now().Add(48 * time.Hour)
+	_, err := svc.CreateRent(&rent.RentRequest{
+		CustomerID: f.customer.ID,
+		VehicleID:  &f.vehicle.ID,
+		EndDate:    &end,
+	}, f.staff.ID)
+	if err == nil {
+		t.Fatal("expected CreateRent to fail when the vehicle update fails")
+	}
+
+	var rents int64
+	db.Model(&rent.Rent{}).Where("customer_id = ?", f.customer.ID).Count(&rents)
+	if rents != 0 {
+		t.Errorf("rents after rollback = %d, want 0", rents)
+	}
+
+	var vh vehicle.Vehicle
+	if err := db.First(&vh, f.vehicle.ID).Error; err != nil {
+		t.Fatalf("reload vehicle: %v", err)
+	}
+	if vh.Status != vehicle.StatusAvailable {
+		t.Errorf("vehicle status = %s, want %s", vh.Status, vehicle.StatusAvailable)
+	}
+}
+
+// Proyeksi harga (dipakai perjanjian sewa dan booking) harus sama dengan
+// total final jika kendaraan kembali tepat di expected return, termasuk
+// potongan promo dan biaya one-way.
+func TestEstimateMatchesCompletedTotal(t *testing.T) {
+	db := openTestDB(t)
+
+	// Promo & branch dibuat sebelum fixture supaya dihapus setelah rent-nya
+	p := newPromo(t, db, 0, 0)
+	now := time.Now()
+	suffix := fmt.Sprintf("%d", now.UnixNano())
+	pickup := &branch.Branch{Code: "P" + suffix[len(suffix)-12:], Name: "Pickup", Active: true}
+	dropoff := &branch.Branch{Code: "D" + suffix[len(suffix)-12:], Name: "Dropoff", Active: true}
+	for _, row := range []interface{}{pickup, dropoff} {
+		if err := db.Create(row).Error; err != nil {
+			t.Fatalf("create fixture: %v", err)
+		}
+	}
+	fee := &branch.OneWayFee{FromBranchID: pickup.ID, ToBranchID: dropoff.ID, Fee: 150000}
+	if err := db.Create(fee).Error; err != nil {
+		t.Fatalf("create one-way fee: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Delete(fee)
+		db.Delete(pickup)
+		db.Delete(dropoff)
+	})
+
+	f := newFixture(t, db)
+	if err := db.Model(f.vehicle).Update("branch_id", pickup.ID).Error; err != nil {
+		t.Fatalf("move vehicle to pickup branch: %v", err)
+	}
+	svc := newRentService(db, vehicle.NewRepository(db))
+
+	end := now.Add(48 * time.Hour)
+	created, err := svc.CreateRent(&rent.RentRequest{
+		CustomerID:      f.customer.ID,
+		VehicleID:       &f.vehicle.ID,
+		EndDate:         &end,
+		PromoCode:       &p.Code,
+		DropoffBranchID: &dropoff.ID,
+	}, f.staff.ID)
+	if err != nil {
+		t.Fatalf("CreateRent: %v", err)
+	}
+	if _, err := svc.CompleteRent(created.ID, &rent.CompleteRentRequest{Reason: "returned"}, f.staff.ID); err != nil {
+		t.Fatalf("CompleteRent: %v", err)
+	}
+
+	// Expected return disamakan dengan waktu kembali sebenarnya
+	var completed rent.Rent
+	if err := db.First(&completed, created.ID).Error; err != nil {
+		t.Fatalf("reload rent: %v", err)
+	}
+	if err := db.Model(&completed).Update("planned_end_date", completed.ReturnDate).Error; err != nil {
+		t.Fatalf("update planned end date: %v", err)
+	}
+
+	quote, err := svc.EstimateRent(created.ID)
+	if err != nil {
+		t.Fatalf("EstimateRent: %v", err)
+	}
+	if math.Abs(quote.Total-completed.TotalPrice) > 0.005 {
+		t.Errorf("estimated total = %.2f, completed total = %.2f", quote.Total, completed.TotalPrice)
+	}
+	types := map[pricing.ChargeType]bool{}
+	for _, item := range quote.Items {
+		types[item.Type] = true
+	}
+	for _, want := range []pricing.ChargeType{pricing.ChargeDiscount, pricing.ChargeOneWayFee} {
+		if !types[want] {
+			t.Errorf("estimate has no %s line: %+v", want, quote.Items)
+		}
+	}
+}
+
+func TestPromoUsageLimit(t *testing.T) {
+	db := openTestDB(t)
+	p := newPromo(t, db, 1, 0)
+	first, second := newFixture(t, db), newFixture(t, db)
+	svc := newRentService(db, vehicle.NewRepository(db))
+
+	end := time.Now().Add(48 * time.Hour)
+	for i, f := range []*fixture{first, second} {
+		_, err := svc.CreateRent(&rent.RentRequest{
+			CustomerID: f.customer.ID,
+			VehicleID:  &f.vehicle.ID,
+			EndDate:    &end,
+			PromoCode:  &p.Code,
+		}, f.staff.ID)
+		if i == 0 && err != nil {
+			t.Fatalf("first redemption: %v", err)
+		}
+		if i == 1 && (err == nil || !strings.Contains(err.Error(), "usage limit reached")) {
+			t.Fatalf("second redemption error = %v, want usage limit reached", err)
+		}
+	}
+
+	var stored promo.Promo
+	if err := db.First(&stored, p.ID).Error; err != nil {
+		t.Fatalf("reload promo: %v", err)
+	}
+	if stored.UsedCount != 1 {
+		t.Errorf("used count = %d, want 1", stored.UsedCount)
+	}
+}
+
+func TestPromoPerCustomerLimit(t *testing.T) {
+	db := openTestDB(t)
+	p := newPromo(t, db, 0, 1)
+	f := newFixture(t, db)
+	svc := newRentService(db, vehicle.NewRepository(db))
+
+	// Rent kedua adalah reservasi kendaraan yang sama setelah rent pertama selesai
+	now := time.Now()
+	firstEnd := now.Add(48 * time.Hour)
+	secondStart, secondEnd := now.Add(72*time.Hour), now.Add(96*time.Hour)
+	_, err := svc.CreateRent(&rent.RentRequest{
+		CustomerID: f.customer.ID,
+		VehicleID:  &f.vehicle.ID,
+		EndDate:    &firstEnd,
+		PromoCode:  &p.Code,
+	}, f.staff.ID)
+	if err != nil {
+		t.Fatalf("first redemption: %v", err)
+	}
+	_, err = svc.CreateRent(&rent.RentRequest{
+		CustomerID: f.customer.ID,
+		VehicleID:  &f.vehicle.ID,
+		StartDate:  &secondStart,
+		EndDate:    &secondEnd,
+		PromoCode:  &p.Code,
+	}, f.staff.ID)
+	if err == nil || !strings.Contains(err.Error(), "usage limit per customer reached") {
+		t.Fatalf("second redemption error = %v, want usage limit per customer reached", err)
+	}
+
+	// Tanpa promo reservasi yang sama tetap bisa dibuat
+	if _, err := svc.CreateRent(&rent.RentRequest{
+		CustomerID: f.customer.ID,
+		VehicleID:  &f.vehicle.ID,
+		StartDate:  &secondStart,
+		EndDate:    &secondEnd,
+	}, f.staff.ID); err != nil {
+		t.Fatalf("reservation without promo: %v", err)
+	}
+}
+
+// Dua customer menukar promo dengan sisa kuota satu secara bersamaan:
+// baris promo terkunci, jadi hanya satu yang berhasil.
+func TestPromoConcurrentRedemptionAtLimit(t *testing.T) {
+	db := openTestDB(t)
+	p := newPromo(t, db, 1, 0)
+	fixtures := []*fixture{newFixture(t, db), newFixture(t, db)}
+	svc := newRentService(db, vehicle.NewRepository(db))
+
+	end := time.Now().Add(48 * time.Hour)
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes int
+		failures  []error
+	)
+	start := make(chan struct{})
+	for _, f := range fixtures {
+		wg.Add(1)
+		go func(f *fixture) {
+			defer wg.Done()
+			<-start
+			_, err := svc.CreateRent(&rent.RentRequest{
+				CustomerID: f.customer.ID,
+				VehicleID:  &f.vehicle.ID,
+				EndDate:    &end,
+				PromoCode:  &p.Code,
+			}, f.staff.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, err)
+				return
+			}
+			successes++
+		}(f)
+	}
+	close(start)
+	wg.Wait()
+
+	if successes != 1 {
+		t.Fatalf("expected exactly 1 successful redemption, got %d (errors: %v)", successes, failures)
+	}
+	if !strings.Contains(failures[0].Error(), "usage limit reached") {
+		t.Errorf("failed redemption error = %v, want usage limit reached", failures[0])
+	}
+
+	var stored promo.Promo
+	if err := db.First(&stored, p.ID).Error; err != nil {
+		t.Fatalf("reload promo: %v", err)
+	}
+	if stored.UsedCount != 1 {
+		t.Errorf("used count = %d, want 1", stored.UsedCount)
+	}
+	var redemptions int64
+	db.Model(&promo.Redemption{}).Where("promo_id = ?", p.ID).Count(&redemptions)
+	if redemptions != 1 {
+		t.Errorf("redemptions = %d, want 1", redemptions)
+	}
+}
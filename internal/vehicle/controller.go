@@ -73,6 +73,40 @@ func (ctrl *Controller) GetVehicles(c *gin.Context) {
 	response.Success(c, http.StatusOK, "vehicles retrieved successfully", vehicles)
 }
 
+// GetAvailableVehicles godoc
+// @Summary Get available vehicles
+// @Description Retrieve vehicles that are free for the whole requested period. Vehicles of a class are limited to the capacity left after class reservations without an assigned vehicle
+// @Tags Vehicle
+// @Produce json
+// @Param from query string true "Period start (RFC3339)"
+// @Param to query string true "Period end (RFC3339)"
+// @Param type query string false "Vehicle type (car/bike)"
+// @Param brand query string false "Brand"
+// @Param model query string false "Model"
+// @Param min_year query int false "Minimum year"
+// @Param max_year query int false "Maximum year"
+// @Param class_id query int false "Vehicle class ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle/available [get]
+func (ctrl *Controller) GetAvailableVehicles(c *gin.Context) {
+	var filter AvailabilityFilter
+
+	// Bind query parameters from URL
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	vehicles, err := ctrl.service.GetAvailableVehicles(&filter)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "available vehicles retrieved successfully", vehicles)
+}
+
 // GetVehicleByID godoc
 // @Summary Get vehicle by ID
 // @Description Retrieve a vehicle by its ID
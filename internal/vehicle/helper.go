@@ -2,13 +2,18 @@ package vehicle
 
 func toVehicleResponse(v *Vehicle) *VehicleResponse {
 	return &VehicleResponse{
-		ID:          v.ID,
-		Type:        v.Type,
-		PlateNumber: v.PlateNumber,
-		Brand:       v.Brand,
-		Model:       v.Model,
-		Year:        v.Year,
-		PricePerDay: v.PricePerDay,
-		Status:      v.Status,
+		ID:           v.ID,
+		Type:         v.Type,
+		PlateNumber:  v.PlateNumber,
+		Brand:        v.Brand,
+		Model:        v.Model,
+		Year:         v.Year,
+		PricePerDay:  v.PricePerDay,
+		Odometer:     v.Odometer,
+		Status:       v.Status,
+		BranchID:     v.BranchID,
+		ClassID:      v.ClassID,
+		PhotoURL:     v.PhotoURL,
+		ThumbnailURL: v.ThumbnailURL,
 	}
 }
@@ -1,6 +1,10 @@
 package vehicle
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type VehicleType string
 type Avaibility string
@@ -16,15 +20,21 @@ const (
 )
 
 type Vehicle struct {
-	ID          uint           `json:"id" gorm:"primaryKey;autoIncrement"`
-	Type        VehicleType    `json:"type" gorm:"type:enum('car', 'bike')"`
-	PlateNumber string         `json:"plate_number" gorm:"type:varchar(20);uniqueIndex"`
-	Brand       string         `json:"brand"`
-	Model       string         `json:"model"`
-	Year        int            `json:"year"`
-	PricePerDay float64        `json:"price_per_day"`
-	Status      Avaibility     `json:"status" gorm:"type:enum('available', 'rented', 'maintenance');default:'available'"`
-	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+	ID          uint        `json:"id" gorm:"primaryKey;autoIncrement"`
+	Type        VehicleType `json:"type" gorm:"type:enum('car', 'bike')"`
+	PlateNumber string      `json:"plate_number" gorm:"type:varchar(20);uniqueIndex"`
+	Brand       string      `json:"brand"`
+	Model       string      `json:"model"`
+	Year        int         `json:"year"`
+	PricePerDay float64     `json:"price_per_day"`
+	Odometer    int         `json:"odometer"` // km, diperbarui dari inspeksi checkout/checkin
+	Status      Avaibility  `json:"status" gorm:"type:enum('available', 'rented', 'maintenance');default:'available'"`
+	BranchID    *uint       `json:"branch_id" gorm:"index;default:null"` // lokasi saat ini, pindah saat dikembalikan di branch lain
+	ClassID     *uint       `json:"class_id" gorm:"index;default:null"`  // class untuk reservasi tanpa plat nomor
+	// Foto utama untuk katalog, disalin dari media primary oleh package media
+	PhotoURL     string         `json:"photo_url"`
+	ThumbnailURL string         `json:"thumbnail_url"`
+	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
 }
 
 type VehicleRequest struct {
@@ -35,17 +45,24 @@ type VehicleRequest struct {
 	Year        int     `json:"year" form:"year" binding:"required"`
 	PricePerDay float64 `json:"price_per_day" form:"price_per_day" binding:"required"`
 	Status      string  `json:"status" form:"status" binding:"required"`
+	BranchID    *uint   `json:"branch_id" form:"branch_id"`
+	ClassID     *uint   `json:"class_id" form:"class_id"`
 }
 
 type VehicleResponse struct {
-	ID          uint        `json:"id"`
-	Type        VehicleType `json:"type"`
-	PlateNumber string      `json:"plate_number"`
-	Brand       string      `json:"brand"`
-	Model       string      `json:"model"`
-	Year        int         `json:"year"`
-	PricePerDay float64     `json:"price_per_day"`
-	Status      Avaibility  `json:"status"`
+	ID           uint        `json:"id"`
+	Type         VehicleType `json:"type"`
+	PlateNumber  string      `json:"plate_number"`
+	Brand        string      `json:"brand"`
+	Model        string      `json:"model"`
+	Year         int         `json:"year"`
+	PricePerDay  float64     `json:"price_per_day"`
+	Odometer     int         `json:"odometer"`
+	Status       Avaibility  `json:"status"`
+	BranchID     *uint       `json:"branch_id"`
+	ClassID      *uint       `json:"class_id"`
+	PhotoURL     string      `json:"photo_url"`
+	ThumbnailURL string      `json:"thumbnail_url"`
 }
 
 type UpdateVehicleRequest struct {
@@ -56,13 +73,24 @@ type UpdateVehicleRequest struct {
 	Year        *int     `json:"year" form:"year" binding:"omitempty"`
 	PricePerDay *float64 `json:"price_per_day" form:"price_per_day" binding:"omitempty"`
 	Status      *string  `json:"status" form:"status" binding:"omitempty"`
+	BranchID    *uint    `json:"branch_id" form:"branch_id" binding:"omitempty"` // pindah lokasi manual
+	ClassID     *uint    `json:"class_id" form:"class_id" binding:"omitempty"`
 }
 
 type VehicleFilter struct {
-    Status *string
-    Brand  *string
-    Model  *string
-    Type   *string
-    MinYear *int
-    MaxYear *int
+    Status   *string `form:"status"`
+    Brand    *string `form:"brand"`
+    Model    *string `form:"model"`
+    Type     *string `form:"type"`
+    MinYear  *int    `form:"min_year"`
+    MaxYear  *int    `form:"max_year"`
+    BranchID *uint   `form:"branch_id"` // lokasi kendaraan saat ini
+    ClassID  *uint   `form:"class_id"`
+}
+
+// AvailabilityFilter = VehicleFilter + periode yang harus kosong penuh
+type AvailabilityFilter struct {
+    VehicleFilter
+    From time.Time `form:"from" binding:"required"`
+    To   time.Time `form:"to"   binding:"required"`
 }
@@ -2,14 +2,22 @@ package vehicle
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository interface {
 	Create(vehicle *Vehicle) error
 	FindByID(id uint) (*Vehicle, error)
+	FindByIDForUpdate(id uint) (*Vehicle, error)
 	FindAll(filter *VehicleFilter) ([]*Vehicle, error)
+	FindAvailable(filter *AvailabilityFilter) ([]*Vehicle, error)
 	Update(vehicle *Vehicle) error
 	Delete(vehicle *Vehicle) error
+	// BranchExists mengecek branch aktif di tabel branches
+	BranchExists(id uint) (bool, error)
+	// FindClassType mengambil tipe kendaraan sebuah class di tabel vehicle_classes
+	FindClassType(id uint) (VehicleType, error)
+	WithTx(tx *gorm.DB) Repository
 }
 
 type repository struct {
@@ -30,7 +38,47 @@ func (r *repository) Delete(vehicle *Vehicle) error {
 // FindAll implements Repository.
 func (r *repository) FindAll(filter *VehicleFilter) ([]*Vehicle, error) {
     var vehicles []*Vehicle
-    query := r.db.Model(&Vehicle{})
+    query := applyVehicleFilter(r.db.Model(&Vehicle{}), filter)
+
+		if err := query.Find(&vehicles).Error; err != nil {
+        return nil, err
+    }
+
+    return vehicles, nil
+}
+
+// FindAvailable implements Repository.
+// Kendaraan dianggap tersedia jika tidak sedang maintenance, tidak ada
+// reservasi / rent ongoing di tabel rents dan tidak ada jadwal servis
+// di maintenance_work_orders yang beririsan dengan [From, To).
+func (r *repository) FindAvailable(filter *AvailabilityFilter) ([]*Vehicle, error) {
+    var vehicles []*Vehicle
+    query := applyVehicleFilter(r.db.Model(&Vehicle{}), &filter.VehicleFilter).
+        Where("vehicles.status <> ?", StatusMaintenance).
+        Where(`NOT EXISTS (
+            SELECT 1 FROM rents
+            WHERE rents.vehicle_id = vehicles.id
+              AND rents.status IN ('reserved', 'ongoing')
+              AND COALESCE(rents.planned_start_date, rents.rent_date) < ?
+              AND (rents.planned_end_date IS NULL OR rents.planned_end_date > ?)
+        )`, filter.To, filter.From).
+        Where(`NOT EXISTS (
+            SELECT 1 FROM maintenance_work_orders
+            WHERE maintenance_work_orders.vehicle_id = vehicles.id
+              AND maintenance_work_orders.status IN ('scheduled', 'in_progress')
+              AND maintenance_work_orders.scheduled_start < ?
+              AND maintenance_work_orders.scheduled_end > ?
+        )`, filter.To, filter.From)
+
+    if err := query.Find(&vehicles).Error; err != nil {
+        return nil, err
+    }
+
+    return vehicles, nil
+}
+
+// applyVehicleFilter dipakai bersama oleh FindAll dan FindAvailable
+func applyVehicleFilter(query *gorm.DB, filter *VehicleFilter) *gorm.DB {
     // FILTER STATUS
     if filter.Status != nil {
         query = query.Where("status = ?", *filter.Status)
@@ -54,14 +102,38 @@ func (r *repository) FindAll(filter *VehicleFilter) ([]*Vehicle, error) {
     if filter.MaxYear != nil {
         query = query.Where("year <= ?", *filter.MaxYear)
     }
-
-		if err := query.Find(&vehicles).Error; err != nil {
-        return nil, err
+    // FILTER BRANCH
+    if filter.BranchID != nil {
+        query = query.Where("vehicles.branch_id = ?", *filter.BranchID)
+    }
+    // FILTER CLASS
+    if filter.ClassID != nil {
+        query = query.Where("vehicles.class_id = ?", *filter.ClassID)
     }
+    return query
+}
 
-    return vehicles, nil
+
+// BranchExists implements Repository.
+// Tabel disebut sebagai string agar package ini tidak import package branch.
+func (r *repository) BranchExists(id uint) (bool, error) {
+	var count int64
+	err := r.db.Table("branches").Where("id = ? AND active = ?", id, true).Count(&count).Error
+	return count > 0, err
 }
 
+// FindClassType implements Repository.
+// Tabel disebut sebagai string agar package ini tidak import package vehicleclass.
+func (r *repository) FindClassType(id uint) (VehicleType, error) {
+	var types []VehicleType
+	if err := r.db.Table("vehicle_classes").Where("id = ?", id).Pluck("type", &types).Error; err != nil {
+		return "", err
+	}
+	if len(types) == 0 {
+		return "", gorm.ErrRecordNotFound
+	}
+	return types[0], nil
+}
 
 // FindByID implements Repository.
 func (r *repository) FindByID(id uint) (*Vehicle, error) {
@@ -72,11 +144,26 @@ func (r *repository) FindByID(id uint) (*Vehicle, error) {
 	return &v, nil
 }
 
+// FindByIDForUpdate implements Repository.
+// Mengunci baris kendaraan (SELECT ... FOR UPDATE), hanya berguna di dalam transaksi.
+func (r *repository) FindByIDForUpdate(id uint) (*Vehicle, error) {
+	var v Vehicle
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&v, id).Error; err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
 // Update implements Repository.
 func (r *repository) Update(vehicle *Vehicle) error {
 	return r.db.Save(vehicle).Error
 }
 
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{tx}
+}
+
 func NewRepository(db *gorm.DB) Repository {
 	return &repository{db}
 }
@@ -12,6 +12,7 @@ func SetupVehicleRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
 	{
 		vehicle.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateVehicle)
 		vehicle.GET("/", ctrl.GetVehicles)
+		vehicle.GET("/available", ctrl.GetAvailableVehicles)
 		vehicle.GET("/:id", ctrl.GetVehicleByID)
 		vehicle.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateVehicle)
 		vehicle.DELETE("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.DeleteVehicle)
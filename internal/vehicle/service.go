@@ -1,20 +1,31 @@
 package vehicle
 
 import (
+	"errors"
 	"fmt"
 	"go-rental/pkg/config"
+	"time"
 )
 
 type Service interface {
 	CreateVehicle(req *VehicleRequest) (*VehicleResponse, error)
 	GetVehicleByID(id uint) (*VehicleResponse, error)
 	GetAllVehicles(filter *VehicleFilter) ([]*VehicleResponse, error)
+	GetAvailableVehicles(filter *AvailabilityFilter) ([]*VehicleResponse, error)
 	UpdateVehicle(id uint, req *UpdateVehicleRequest) (*VehicleResponse, error)
 	DeleteVehicle(id uint) error
 }
 
+// ClassCapacity diimplementasikan oleh rent.Service yang menghitung
+// pemakaian class, termasuk reservasi class yang belum punya kendaraan.
+// Didefinisikan di sini agar package vehicle tidak perlu import package rent.
+type ClassCapacity interface {
+	ClassFreeCount(classID uint, from, to time.Time) (int, error)
+}
+
 type service struct {
-	repo Repository
+	repo     Repository
+	capacity ClassCapacity
 }
 
 // CreateVehicle implements Service.
@@ -27,7 +38,15 @@ func (s *service) CreateVehicle(req *VehicleRequest) (*VehicleResponse, error) {
 		Year:        req.Year,
 		PricePerDay: req.PricePerDay,
 		Status:      Avaibility(req.Status),
+		BranchID:    req.BranchID,
+	}
+	if err := s.checkBranch(req.BranchID); err != nil {
+		return nil, err
+	}
+	if err := s.checkClass(req.ClassID, vehicle.Type); err != nil {
+		return nil, err
 	}
+	vehicle.ClassID = req.ClassID
 
 	if err := s.repo.Create(vehicle); err != nil {
 		return nil, err
@@ -65,6 +84,28 @@ func (s *service) GetAllVehicles(filter *VehicleFilter) ([]*VehicleResponse, err
 	return responses, nil
 }
 
+// GetAvailableVehicles implements Service.
+func (s *service) GetAvailableVehicles(filter *AvailabilityFilter) ([]*VehicleResponse, error) {
+	if !filter.To.After(filter.From) {
+		return nil, errors.New("to must be after from")
+	}
+
+	vehicles, err := s.repo.FindAvailable(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve available vehicles: %w", err)
+	}
+	if vehicles, err = s.limitByClass(vehicles, filter.From, filter.To); err != nil {
+		return nil, fmt.Errorf("failed to check class capacity: %w", err)
+	}
+
+	var responses []*VehicleResponse
+	for _, v := range vehicles {
+		responses = append(responses, toVehicleResponse(v))
+	}
+
+	return responses, nil
+}
+
 // GetVehicleByID implements Service.
 func (s *service) GetVehicleByID(id uint) (*VehicleResponse, error) {
 	vehicle, err := s.repo.FindByID(id)
@@ -103,6 +144,21 @@ func (s *service) UpdateVehicle(id uint, req *UpdateVehicleRequest) (*VehicleRes
 	if req.Status != nil {
 		vehicle.Status = Avaibility(*req.Status)
 	}
+	if req.BranchID != nil {
+		if err := s.checkBranch(req.BranchID); err != nil {
+			return nil, err
+		}
+		vehicle.BranchID = req.BranchID
+	}
+	if req.ClassID != nil {
+		vehicle.ClassID = req.ClassID
+	}
+	// Tipe kendaraan harus tetap sama dengan tipe class-nya
+	if req.ClassID != nil || req.Type != nil {
+		if err := s.checkClass(vehicle.ClassID, vehicle.Type); err != nil {
+			return nil, err
+		}
+	}
 
 	// Save changes
 	if err := s.repo.Update(vehicle); err != nil {
@@ -112,8 +168,72 @@ func (s *service) UpdateVehicle(id uint, req *UpdateVehicleRequest) (*VehicleRes
 	return toVehicleResponse(vehicle), nil
 }
 
-func NewService(repo Repository, cfg *config.Config) Service {
+// checkBranch memastikan branch ada dan aktif, nil = tanpa lokasi
+func (s *service) checkBranch(branchID *uint) error {
+	if branchID == nil {
+		return nil
+	}
+	ok, err := s.repo.BranchExists(*branchID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("branch not found or inactive")
+	}
+	return nil
+}
+
+// checkClass memastikan class ada dan tipenya sama dengan tipe kendaraan, nil = tanpa class
+func (s *service) checkClass(classID *uint, vehicleType VehicleType) error {
+	if classID == nil {
+		return nil
+	}
+	classType, err := s.repo.FindClassType(*classID)
+	if err != nil {
+		return errors.New("vehicle class not found")
+	}
+	if classType != vehicleType {
+		return fmt.Errorf("vehicle class is for %s, not %s", classType, vehicleType)
+	}
+	return nil
+}
+
+// limitByClass membatasi jumlah kendaraan per class sebanyak sisa kapasitas
+// class pada periode tersebut. Reservasi class belum terikat ke kendaraan,
+// jadi tidak terlihat di pengecekan per kendaraan FindAvailable.
+func (s *service) limitByClass(vehicles []*Vehicle, from, to time.Time) ([]*Vehicle, error) {
+	if s.capacity == nil {
+		return vehicles, nil
+	}
+	free := map[uint]int{}
+	limited := make([]*Vehicle, 0, len(vehicles))
+	for _, v := range vehicles {
+		if v.ClassID == nil {
+			limited = append(limited, v)
+			continue
+		}
+		remaining, ok := free[*v.ClassID]
+		if !ok {
+			count, err := s.capacity.ClassFreeCount(*v.ClassID, from, to)
+			if err != nil {
+				return nil, err
+			}
+			remaining = count
+		}
+		if remaining > 0 {
+			limited = append(limited, v)
+			remaining--
+		}
+		free[*v.ClassID] = remaining
+	}
+	return limited, nil
+}
+
+// NewService membuat vehicle service. capacity boleh nil jika daftar
+// kendaraan available tidak dipakai (tanpa pembatasan kapasitas class).
+func NewService(repo Repository, capacity ClassCapacity, cfg *config.Config) Service {
 	return &service{
-		repo: repo,
+		repo:     repo,
+		capacity: capacity,
 	}
 }
@@ -0,0 +1,164 @@
+package vehicleclass
+
+import (
+	"go-rental/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service  Service
+	capacity CapacityChecker
+}
+
+func NewController(s Service, capacity CapacityChecker) *Controller {
+	return &Controller{
+		service:  s,
+		capacity: capacity,
+	}
+}
+
+// CreateClass godoc
+// @Summary Create vehicle class
+// @Description Create a vehicle class such as "Compact Automatic" that customers can reserve instead of a specific plate number
+// @Tags Vehicle Class
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param data body ClassRequest true "Vehicle class data"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle-class/ [post]
+func (ctrl *Controller) CreateClass(c *gin.Context) {
+	var req ClassRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	class, err := ctrl.service.CreateClass(&req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusCreated, "vehicle class created successfully", class)
+}
+
+// GetClasses godoc
+// @Summary Get vehicle classes
+// @Description Retrieve vehicle classes, optionally filtered by type or status
+// @Tags Vehicle Class
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Vehicle type (car/bike)"
+// @Param active query bool false "Active only"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle-class/ [get]
+func (ctrl *Controller) GetClasses(c *gin.Context) {
+	var filter ClassFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	classes, err := ctrl.service.GetAllClasses(&filter)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle classes retrieved successfully", classes)
+}
+
+// GetClassByID godoc
+// @Summary Get vehicle class by ID
+// @Description Retrieve a vehicle class by its ID
+// @Tags Vehicle Class
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle class ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/vehicle-class/{id} [get]
+func (ctrl *Controller) GetClassByID(c *gin.Context) {
+	classID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle class ID")
+		return
+	}
+
+	class, err := ctrl.service.GetClassByID(uint(classID))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle class retrieved successfully", class)
+}
+
+// UpdateClass godoc
+// @Summary Update vehicle class
+// @Description Update the name, reference rate or status of a vehicle class; inactive classes cannot be reserved
+// @Tags Vehicle Class
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle class ID"
+// @Param data body UpdateClassRequest true "Vehicle class update data"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle-class/{id} [put]
+func (ctrl *Controller) UpdateClass(c *gin.Context) {
+	classID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle class ID")
+		return
+	}
+
+	var req UpdateClassRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	class, err := ctrl.service.UpdateClass(uint(classID), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle class updated successfully", class)
+}
+
+// GetAvailability godoc
+// @Summary Get vehicle class availability
+// @Description Count the vehicles of a class that are still free for the whole period, taking reservations and maintenance into account
+// @Tags Vehicle Class
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Vehicle class ID"
+// @Param from query string true "Period start (RFC3339)"
+// @Param to query string true "Period end (RFC3339)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/vehicle-class/{id}/availability [get]
+func (ctrl *Controller) GetAvailability(c *gin.Context) {
+	classID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid vehicle class ID")
+		return
+	}
+
+	var filter AvailabilityFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid filter parameters: "+err.Error())
+		return
+	}
+
+	availability, err := ctrl.capacity.ClassAvailability(uint(classID), filter.From, filter.To)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, http.StatusOK, "vehicle class availability retrieved successfully", availability)
+}
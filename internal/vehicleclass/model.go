@@ -0,0 +1,63 @@
+package vehicleclass
+
+import (
+	"go-rental/internal/vehicle"
+	"time"
+)
+
+// Class mengelompokkan kendaraan yang setara, contoh "Compact Automatic"
+// atau "Scooter 125cc". Customer bisa reservasi per class; kendaraan
+// konkret baru dipilih saat pickup. PricePerDay adalah tarif acuan untuk
+// estimasi sebelum kendaraan di-assign.
+type Class struct {
+	ID          uint                `json:"id" gorm:"primaryKey;autoIncrement"`
+	Code        string              `json:"code" gorm:"type:varchar(30);uniqueIndex"`
+	Name        string              `json:"name"`
+	Type        vehicle.VehicleType `json:"type" gorm:"type:enum('car', 'bike')"`
+	Description string              `json:"description"`
+	PricePerDay float64             `json:"price_per_day"`
+	Active      bool                `json:"active"` // class nonaktif tidak bisa direservasi
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+func (Class) TableName() string {
+	return "vehicle_classes"
+}
+
+type ClassRequest struct {
+	Code        string  `json:"code" form:"code" binding:"required,max=30"`
+	Name        string  `json:"name" form:"name" binding:"required"`
+	Type        string  `json:"type" form:"type" binding:"required,oneof=car bike"`
+	Description string  `json:"description" form:"description"`
+	PricePerDay float64 `json:"price_per_day" form:"price_per_day" binding:"required,gt=0"`
+}
+
+type UpdateClassRequest struct {
+	Name        *string  `json:"name" form:"name" binding:"omitempty"`
+	Description *string  `json:"description" form:"description" binding:"omitempty"`
+	PricePerDay *float64 `json:"price_per_day" form:"price_per_day" binding:"omitempty,gt=0"`
+	Active      *bool    `json:"active" form:"active" binding:"omitempty"`
+}
+
+type ClassFilter struct {
+	Type   *string `form:"type" binding:"omitempty,oneof=car bike"`
+	Active *bool   `form:"active"`
+}
+
+type AvailabilityFilter struct {
+	From time.Time `form:"from" binding:"required"`
+	To   time.Time `form:"to"   binding:"required"`
+}
+
+// Availability adalah kapasitas class selama satu periode. Reserved adalah
+// jumlah kendaraan terpakai pada saat tersibuk dalam periode tersebut
+// (rent, reservasi class dan jadwal servis).
+type Availability struct {
+	Class     *Class `json:"class"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Total     int    `json:"total"` // kendaraan class yang tidak sedang maintenance
+	Reserved  int    `json:"reserved"`
+	Available int    `json:"available"`
+}
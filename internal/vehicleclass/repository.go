@@ -0,0 +1,74 @@
+package vehicleclass
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Create(class *Class) error
+	FindByID(id uint) (*Class, error)
+	FindByIDForUpdate(id uint) (*Class, error)
+	FindAll(filter *ClassFilter) ([]*Class, error)
+	Update(class *Class) error
+
+	WithTx(tx *gorm.DB) Repository
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+// Create implements Repository.
+func (r *repository) Create(class *Class) error {
+	return r.db.Create(class).Error
+}
+
+// FindByID implements Repository.
+func (r *repository) FindByID(id uint) (*Class, error) {
+	var class Class
+	if err := r.db.First(&class, id).Error; err != nil {
+		return nil, err
+	}
+	return &class, nil
+}
+
+// FindByIDForUpdate implements Repository.
+// Mengunci baris class, hanya berguna di dalam transaksi.
+func (r *repository) FindByIDForUpdate(id uint) (*Class, error) {
+	var class Class
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, id).Error; err != nil {
+		return nil, err
+	}
+	return &class, nil
+}
+
+// FindAll implements Repository.
+func (r *repository) FindAll(filter *ClassFilter) ([]*Class, error) {
+	var classes []*Class
+	query := r.db.Model(&Class{})
+	if filter.Type != nil {
+		query = query.Where("type = ?", *filter.Type)
+	}
+	if filter.Active != nil {
+		query = query.Where("active = ?", *filter.Active)
+	}
+	if err := query.Order("type asc, price_per_day asc, id asc").Find(&classes).Error; err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
+
+// Update implements Repository.
+func (r *repository) Update(class *Class) error {
+	return r.db.Save(class).Error
+}
+
+// WithTx implements Repository.
+func (r *repository) WithTx(tx *gorm.DB) Repository {
+	return &repository{db: tx}
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
@@ -0,0 +1,19 @@
+package vehicleclass
+
+import (
+	"go-rental/pkg/config"
+	"go-rental/pkg/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupVehicleClassRoutes(r *gin.Engine, ctrl *Controller, cfg *config.Config) {
+	class := r.Group("/api/vehicle-class")
+	{
+		class.POST("/", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.CreateClass)
+		class.GET("/", middlewares.Authenticate(cfg), ctrl.GetClasses)
+		class.GET("/:id", middlewares.Authenticate(cfg), ctrl.GetClassByID)
+		class.PUT("/:id", middlewares.Authenticate(cfg), middlewares.Authorize("admin"), ctrl.UpdateClass)
+		class.GET("/:id/availability", middlewares.Authenticate(cfg), ctrl.GetAvailability)
+	}
+}
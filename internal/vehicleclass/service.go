@@ -0,0 +1,110 @@
+package vehicleclass
+
+import (
+	"errors"
+	"fmt"
+	"go-rental/internal/vehicle"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	CreateClass(req *ClassRequest) (*Class, error)
+	GetAllClasses(filter *ClassFilter) ([]*Class, error)
+	GetClassByID(id uint) (*Class, error)
+	UpdateClass(id uint, req *UpdateClassRequest) (*Class, error)
+
+	// Dipanggil dari transaksi rent. Baris class dikunci supaya cek
+	// kapasitas untuk class yang sama diproses bergantian.
+	Lock(tx *gorm.DB, id uint) (*Class, error)
+}
+
+// CapacityChecker diimplementasikan oleh rent.Service yang menghitung
+// pemakaian class. Didefinisikan di sini agar package vehicleclass tidak
+// perlu import package rent (import cycle).
+type CapacityChecker interface {
+	ClassAvailability(classID uint, from, to time.Time) (*Availability, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+// CreateClass implements Service.
+func (s *service) CreateClass(req *ClassRequest) (*Class, error) {
+	class := &Class{
+		Code:        strings.ToUpper(strings.TrimSpace(req.Code)),
+		Name:        req.Name,
+		Type:        vehicle.VehicleType(req.Type),
+		Description: req.Description,
+		PricePerDay: req.PricePerDay,
+		Active:      true,
+	}
+	if err := s.repo.Create(class); err != nil {
+		return nil, fmt.Errorf("failed to create vehicle class: %w", err)
+	}
+	return class, nil
+}
+
+// GetAllClasses implements Service.
+func (s *service) GetAllClasses(filter *ClassFilter) ([]*Class, error) {
+	classes, err := s.repo.FindAll(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve vehicle classes: %w", err)
+	}
+	return classes, nil
+}
+
+// GetClassByID implements Service.
+func (s *service) GetClassByID(id uint) (*Class, error) {
+	class, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("vehicle class not found")
+	}
+	return class, nil
+}
+
+// UpdateClass implements Service.
+// Type tidak bisa diubah karena kendaraan di dalamnya harus bertipe sama.
+func (s *service) UpdateClass(id uint, req *UpdateClassRequest) (*Class, error) {
+	class, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("vehicle class not found")
+	}
+
+	// Update only fields that are not nil
+	if req.Name != nil {
+		class.Name = *req.Name
+	}
+	if req.Description != nil {
+		class.Description = *req.Description
+	}
+	if req.PricePerDay != nil {
+		class.PricePerDay = *req.PricePerDay
+	}
+	if req.Active != nil {
+		class.Active = *req.Active
+	}
+
+	if err := s.repo.Update(class); err != nil {
+		return nil, fmt.Errorf("failed to update vehicle class: %w", err)
+	}
+	return class, nil
+}
+
+// Lock implements Service.
+func (s *service) Lock(tx *gorm.DB, id uint) (*Class, error) {
+	class, err := s.repo.WithTx(tx).FindByIDForUpdate(id)
+	if err != nil {
+		return nil, fmt.Errorf("vehicle class %d not found", id)
+	}
+	return class, nil
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo: repo,
+	}
+}
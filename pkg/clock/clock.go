@@ -0,0 +1,159 @@
+// Package clock menyimpan zona waktu bisnis dan aturan hari tertagih.
+// Semua waktu disimpan di database dalam UTC, lalu dikonversi ke zona
+// bisnis saat ditampilkan atau saat menentukan batas hari.
+package clock
+
+import (
+	"fmt"
+	"go-rental/pkg/config"
+	"time"
+)
+
+type BillingMode string
+
+const (
+	// BillingBlock24h: satu hari = blok 24 jam sejak pickup
+	BillingBlock24h BillingMode = "24h"
+	// BillingCalendar: setiap tanggal kalender (zona bisnis) yang tersentuh dihitung satu hari
+	BillingCalendar BillingMode = "calendar"
+)
+
+var (
+	location     = time.UTC
+	billingMode  = BillingBlock24h
+	billingGrace time.Duration
+)
+
+// Setup membaca zona waktu dan aturan hari tertagih dari config.
+// Dipanggil sekali saat startup sebelum server jalan.
+func Setup(cfg *config.Config) error {
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid TIMEZONE %q: %w", cfg.Timezone, err)
+	}
+
+	mode := BillingMode(cfg.BillingDayMode)
+	if mode != BillingBlock24h && mode != BillingCalendar {
+		return fmt.Errorf("invalid BILLING_DAY_MODE %q, use 24h or calendar", cfg.BillingDayMode)
+	}
+
+	grace, err := time.ParseDuration(cfg.BillingGracePeriod)
+	if err != nil || grace < 0 {
+		return fmt.Errorf("invalid BILLING_GRACE_PERIOD %q", cfg.BillingGracePeriod)
+	}
+
+	location, billingMode, billingGrace = loc, mode, grace
+	return nil
+}
+
+// Location mengembalikan zona waktu bisnis
+func Location() *time.Location {
+	return location
+}
+
+// Format menampilkan waktu dalam RFC 3339 dengan offset zona bisnis.
+// Waktu kosong (zero) menghasilkan string kosong.
+func Format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(location).Format(time.RFC3339)
+}
+
+// FormatPtr sama seperti Format untuk field nullable
+func FormatPtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return Format(*t)
+}
+
+// In mengonversi t ke zona bisnis. Dipakai oleh MarshalJSON model supaya
+// field time.Time tidak dikirim sebagai UTC "Z".
+func In(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(location)
+}
+
+// InPtr sama seperti In untuk field nullable
+func InPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := In(*t)
+	return &local
+}
+
+// Date mengembalikan tanggal kalender (YYYY-MM-DD) t di zona bisnis
+func Date(t time.Time) string {
+	return t.In(location).Format("2006-01-02")
+}
+
+// StartOfDay mengembalikan awal hari (00:00) zona bisnis pada tanggal t.
+// Di zona yang jam 00:00-nya hilang saat DST, dipakai jam valid pertama.
+func StartOfDay(t time.Time) time.Time {
+	t = t.In(location)
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+	for start.Day() != t.Day() {
+		start = start.Add(time.Hour)
+	}
+	return start
+}
+
+// BillingDays mengembalikan awal setiap hari tertagih pada periode [start, end).
+// Minimal satu hari. Grace period memberi toleransi di ujung periode:
+//   - 24h: sisa jam <= grace tidak membuka blok 24 jam baru
+//   - calendar: masuk tanggal baru <= grace setelah 00:00 tidak dihitung hari baru
+//
+// Mode calendar berjalan per tanggal (AddDate), jadi hari 23/25 jam saat DST
+// tetap dihitung satu hari. Mode 24h murni durasi.
+func BillingDays(start, end time.Time) []time.Time {
+	if billingMode == BillingCalendar {
+		return calendarDays(start, end, billingGrace)
+	}
+	return blockDays(start, end, billingGrace)
+}
+
+func blockDays(start, end time.Time, grace time.Duration) []time.Time {
+	const day = 24 * time.Hour
+	duration := end.Sub(start)
+	count := int(duration / day)
+	if remainder := duration % day; remainder > grace {
+		count++
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	days := make([]time.Time, 0, count)
+	for i := 0; i < count; i++ {
+		days = append(days, start.Add(time.Duration(i)*day).In(location))
+	}
+	return days
+}
+
+func calendarDays(start, end time.Time, grace time.Duration) []time.Time {
+	first := StartOfDay(start)
+	last := first
+	if end.After(start) {
+		// Instant terakhir yang dipakai; tepat 00:00 (+grace) belum membuka tanggal baru
+		lastUsed := end.Add(-grace)
+		if !lastUsed.After(start) {
+			lastUsed = start
+		}
+		last = StartOfDay(lastUsed)
+		if !lastUsed.After(last) && last.After(first) {
+			last = last.AddDate(0, 0, -1)
+		}
+	}
+
+	// Iterasi tanggal di UTC (tanpa DST), lalu kembalikan awal hari zona bisnis
+	days := []time.Time{}
+	to := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	for d := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC); !d.After(to); d = d.AddDate(0, 0, 1) {
+		days = append(days, StartOfDay(time.Date(d.Year(), d.Month(), d.Day(), 12, 0, 0, 0, location)))
+	}
+	return days
+}
@@ -0,0 +1,197 @@
+package clock
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // zona DST tetap tersedia di mesin tanpa zoneinfo
+)
+
+// useConfig mengganti zona & aturan hari tertagih selama satu test
+func useConfig(t *testing.T, zone string, mode BillingMode, grace time.Duration) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		t.Fatalf("load location %s: %v", zone, err)
+	}
+	oldLoc, oldMode, oldGrace := location, billingMode, billingGrace
+	location, billingMode, billingGrace = loc, mode, grace
+	t.Cleanup(func() {
+		location, billingMode, billingGrace = oldLoc, oldMode, oldGrace
+	})
+	return loc
+}
+
+func mustParse(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse %s: %v", value, err)
+	}
+	return parsed
+}
+
+func TestBillingDays(t *testing.T) {
+	const (
+		newYork = "America/New_York"
+		jakarta = "Asia/Jakarta"
+	)
+	tests := []struct {
+		name  string
+		zone  string
+		mode  BillingMode
+		grace time.Duration
+		start string
+		end   string
+		want  []string // awal setiap hari tertagih
+	}{
+		// 24h: murni durasi, hari DST 23/25 jam tidak diratakan
+		{
+			name: "24h spring forward day is 23h", zone: newYork, mode: BillingBlock24h,
+			start: "2026-03-08T00:00:00-05:00", end: "2026-03-09T00:00:00-04:00",
+			want: []string{"2026-03-08T00:00:00-05:00"},
+		},
+		{
+			name: "24h spring forward wall clock +1 day is one block", zone: newYork, mode: BillingBlock24h,
+			start: "2026-03-07T10:00:00-05:00", end: "2026-03-08T11:00:00-04:00",
+			want: []string{"2026-03-07T10:00:00-05:00"},
+		},
+		{
+			name: "24h spring forward extra 30m opens new block", zone: newYork, mode: BillingBlock24h,
+			start: "2026-03-07T10:00:00-05:00", end: "2026-03-08T11:30:00-04:00",
+			want: []string{"2026-03-07T10:00:00-05:00", "2026-03-08T11:00:00-04:00"},
+		},
+		{
+			name: "24h spring forward extra 30m within grace", zone: newYork, mode: BillingBlock24h, grace: time.Hour,
+			start: "2026-03-07T10:00:00-05:00", end: "2026-03-08T11:30:00-04:00",
+			want: []string{"2026-03-07T10:00:00-05:00"},
+		},
+		{
+			name: "24h fall back day is 25h", zone: newYork, mode: BillingBlock24h,
+			start: "2026-11-01T00:00:00-04:00", end: "2026-11-02T00:00:00-05:00",
+			want: []string{"2026-11-01T00:00:00-04:00", "2026-11-01T23:00:00-05:00"},
+		},
+		{
+			name: "24h fall back extra hour within grace", zone: newYork, mode: BillingBlock24h, grace: time.Hour,
+			start: "2026-11-01T00:00:00-04:00", end: "2026-11-02T00:00:00-05:00",
+			want: []string{"2026-11-01T00:00:00-04:00"},
+		},
+		{
+			name: "24h jakarta exactly one day", zone: jakarta, mode: BillingBlock24h,
+			start: "2026-01-10T00:00:00+07:00", end: "2026-01-11T00:00:00+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00"},
+		},
+		{
+			name: "24h jakarta one second past midnight", zone: jakarta, mode: BillingBlock24h,
+			start: "2026-01-10T00:00:00+07:00", end: "2026-01-11T00:00:01+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00", "2026-01-11T00:00:00+07:00"},
+		},
+		{
+			name: "24h jakarta past midnight within grace", zone: jakarta, mode: BillingBlock24h, grace: time.Hour,
+			start: "2026-01-10T00:00:00+07:00", end: "2026-01-11T00:30:00+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00"},
+		},
+		{
+			name: "24h zero duration is minimum one day", zone: jakarta, mode: BillingBlock24h,
+			start: "2026-01-10T09:00:00+07:00", end: "2026-01-10T09:00:00+07:00",
+			want: []string{"2026-01-10T09:00:00+07:00"},
+		},
+
+		// calendar: setiap tanggal yang tersentuh, hari DST tetap satu hari
+		{
+			name: "calendar spring forward midnight to midnight", zone: newYork, mode: BillingCalendar,
+			start: "2026-03-08T00:00:00-05:00", end: "2026-03-09T00:00:00-04:00",
+			want: []string{"2026-03-08T00:00:00-05:00"},
+		},
+		{
+			name: "calendar spring forward across two dates", zone: newYork, mode: BillingCalendar,
+			start: "2026-03-07T10:00:00-05:00", end: "2026-03-08T10:00:00-04:00",
+			want: []string{"2026-03-07T00:00:00-05:00", "2026-03-08T00:00:00-05:00"},
+		},
+		{
+			name: "calendar spring forward past midnight", zone: newYork, mode: BillingCalendar,
+			start: "2026-03-08T09:00:00-04:00", end: "2026-03-09T00:30:00-04:00",
+			want: []string{"2026-03-08T00:00:00-05:00", "2026-03-09T00:00:00-04:00"},
+		},
+		{
+			name: "calendar spring forward past midnight within grace", zone: newYork, mode: BillingCalendar, grace: time.Hour,
+			start: "2026-03-08T09:00:00-04:00", end: "2026-03-09T00:30:00-04:00",
+			want: []string{"2026-03-08T00:00:00-05:00"},
+		},
+		{
+			name: "calendar fall back 25h day", zone: newYork, mode: BillingCalendar,
+			start: "2026-11-01T00:00:00-04:00", end: "2026-11-02T00:00:00-05:00",
+			want: []string{"2026-11-01T00:00:00-04:00"},
+		},
+		{
+			name: "calendar fall back next morning", zone: newYork, mode: BillingCalendar, grace: time.Hour,
+			start: "2026-10-31T18:00:00-04:00", end: "2026-11-02T09:00:00-05:00",
+			want: []string{"2026-10-31T00:00:00-04:00", "2026-11-01T00:00:00-04:00", "2026-11-02T00:00:00-05:00"},
+		},
+		{
+			name: "calendar jakarta ends exactly at midnight", zone: jakarta, mode: BillingCalendar,
+			start: "2026-01-10T23:30:00+07:00", end: "2026-01-11T00:00:00+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00"},
+		},
+		{
+			name: "calendar jakarta one second past midnight", zone: jakarta, mode: BillingCalendar,
+			start: "2026-01-10T23:30:00+07:00", end: "2026-01-11T00:00:01+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00", "2026-01-11T00:00:00+07:00"},
+		},
+		{
+			name: "calendar jakarta past midnight within grace", zone: jakarta, mode: BillingCalendar, grace: time.Hour,
+			start: "2026-01-10T23:30:00+07:00", end: "2026-01-11T01:00:00+07:00",
+			want: []string{"2026-01-10T00:00:00+07:00"},
+		},
+		{
+			name: "calendar jakarta utc input crosses local midnight", zone: jakarta, mode: BillingCalendar,
+			start: "2026-01-10T16:30:00Z", end: "2026-01-10T17:30:00Z",
+			want: []string{"2026-01-10T00:00:00+07:00", "2026-01-11T00:00:00+07:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useConfig(t, tt.zone, tt.mode, tt.grace)
+			days := BillingDays(mustParse(t, tt.start), mustParse(t, tt.end))
+
+			got := make([]string, len(days))
+			for i, d := range days {
+				got[i] = d.Format(time.RFC3339)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d days %v, want %d days %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("day %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStartOfDay(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		at   string
+		want string
+	}{
+		{"jakarta late evening utc", "Asia/Jakarta", "2026-01-10T17:30:00Z", "2026-01-11T00:00:00+07:00"},
+		{"jakarta exactly midnight", "Asia/Jakarta", "2026-01-11T00:00:00+07:00", "2026-01-11T00:00:00+07:00"},
+		{"new york spring forward", "America/New_York", "2026-03-08T12:00:00-04:00", "2026-03-08T00:00:00-05:00"},
+		{"new york fall back", "America/New_York", "2026-11-01T12:00:00-05:00", "2026-11-01T00:00:00-04:00"},
+		// Sao Paulo 2018: jam 00:00 hilang, hari dimulai 01:00
+		{"sao paulo missing midnight", "America/Sao_Paulo", "2018-11-04T12:00:00-02:00", "2018-11-04T01:00:00-02:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useConfig(t, tt.zone, BillingCalendar, 0)
+			got := StartOfDay(mustParse(t, tt.at)).Format(time.RFC3339)
+			if got != tt.want {
+				t.Errorf("StartOfDay(%s) = %s, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -23,6 +23,46 @@ type Config struct {
 		Port       string // Port untuk aplikasi web server
 		NodeEnv    string // Environment mode (development/production)
 		CorsOrigin string // Allowed CORS origin (URL frontend)
+		UploadDir  string // Folder penyimpanan file upload (foto inspeksi, dll)
+		DocumentDir string // Folder dokumen privat (perjanjian sewa), tidak disajikan publik
+
+		// Rental agreement configuration
+		AgreementTermsFile string // File teks syarat & ketentuan, satu pasal per baris (kosong = default)
+
+		// Media storage configuration (foto & dokumen kendaraan)
+		StorageDriver    string // local = simpan di UploadDir, s3 = object storage S3-compatible
+		StoragePublicURL string // Base URL file lokal (default: /uploads)
+		S3Endpoint       string // Endpoint S3 / MinIO (contoh: http://localhost:9000)
+		S3Region         string // Region S3 (default: us-east-1)
+		S3Bucket         string // Nama bucket
+		S3AccessKey      string // Access key
+		S3SecretKey      string // Secret key
+		S3UsePathStyle   string // true untuk MinIO (endpoint/bucket/key)
+		S3PublicURL      string // Base URL publik object (CDN / bucket publik), opsional
+
+		// Timezone & billing day configuration
+		Timezone           string // Zona waktu bisnis untuk tampilan & batas hari (contoh: Asia/Jakarta)
+		DBTimezone         string // Zona waktu kolom DATETIME di database (default: UTC)
+		BillingDayMode     string // 24h = blok 24 jam sejak pickup, calendar = per tanggal kalender
+		BillingGracePeriod string // Toleransi di ujung periode sebelum dihitung hari baru (contoh: 1h)
+
+		// Rent late return configuration
+		LateGracePeriod   string // Toleransi keterlambatan sebelum dianggap overdue (contoh: 1h)
+		LateFeePercent    string // Denda per hari terlambat, persen dari tarif harian (contoh: 50)
+
+		// Payment configuration
+		UnpaidCompletionPolicy string // warn = complete tetap jalan dengan peringatan, block = tolak complete
+
+		// Vehicle document configuration
+		MissingDocumentPolicy string // allow = kendaraan tanpa catatan dokumen wajib boleh disewa, block = ditolak
+
+		// Invoice configuration (data perusahaan yang dicetak di invoice)
+		CompanyName       string // Nama perusahaan
+		CompanyAddress    string // Alamat perusahaan
+		CompanyPhone      string // Nomor telepon perusahaan
+		CompanyEmail      string // Email perusahaan
+		CompanyTaxID      string // NPWP perusahaan
+		InvoiceTaxPercent string // Persen pajak yang sudah termasuk di harga (contoh: 11)
 		
 		// Mailjet email configuration
 		MailjetAPIKey     string // Mailjet API key
@@ -57,6 +97,46 @@ func LoadConfig() *Config {
 		Port:       getEnv("PORT", "5000"),
 		NodeEnv:    getEnv("NODE_ENV", "development"),
 		CorsOrigin: getEnv("CORS_ORIGIN", "http://localhost:3000"),
+		UploadDir:  getEnv("UPLOAD_DIR", "uploads"),
+		DocumentDir: getEnv("DOCUMENT_DIR", "storage/documents"),
+
+		// Rental agreement configuration
+		AgreementTermsFile: getEnv("AGREEMENT_TERMS_FILE", ""),
+
+		// Media storage configuration
+		StorageDriver:    getEnv("STORAGE_DRIVER", "local"),
+		StoragePublicURL: getEnv("STORAGE_PUBLIC_URL", "/uploads"),
+		S3Endpoint:       getEnv("S3_ENDPOINT", ""),
+		S3Region:         getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:         getEnv("S3_BUCKET", ""),
+		S3AccessKey:      getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:      getEnv("S3_SECRET_KEY", ""),
+		S3UsePathStyle:   getEnv("S3_USE_PATH_STYLE", "false"),
+		S3PublicURL:      getEnv("S3_PUBLIC_URL", ""),
+
+		// Timezone & billing day configuration
+		Timezone:           getEnv("TIMEZONE", "Asia/Jakarta"),
+		DBTimezone:         getEnv("DB_TIMEZONE", "UTC"),
+		BillingDayMode:     getEnv("BILLING_DAY_MODE", "24h"),
+		BillingGracePeriod: getEnv("BILLING_GRACE_PERIOD", "0s"),
+
+		// Rent late return configuration
+		LateGracePeriod: getEnv("LATE_GRACE_PERIOD", "1h"),
+		LateFeePercent:  getEnv("LATE_FEE_PERCENT", "50"),
+
+		// Payment configuration
+		UnpaidCompletionPolicy: getEnv("UNPAID_COMPLETION_POLICY", "warn"),
+
+		// Vehicle document configuration
+		MissingDocumentPolicy: getEnv("MISSING_DOCUMENT_POLICY", "allow"),
+
+		// Invoice configuration
+		CompanyName:       getEnv("COMPANY_NAME", "GO-RENTAL"),
+		CompanyAddress:    getEnv("COMPANY_ADDRESS", ""),
+		CompanyPhone:      getEnv("COMPANY_PHONE", ""),
+		CompanyEmail:      getEnv("COMPANY_EMAIL", ""),
+		CompanyTaxID:      getEnv("COMPANY_TAX_ID", ""),
+		InvoiceTaxPercent: getEnv("INVOICE_TAX_PERCENT", "11"),
 		
 		// Mailjet configuration
 		MailjetAPIKey:    getEnv("MAILJET_API_KEY", ""),
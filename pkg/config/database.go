@@ -6,6 +6,7 @@ package config
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"time"
 
 	"gorm.io/driver/mysql"
@@ -24,17 +25,22 @@ var DB *gorm.DB
 func Connect(cfg *Config) error {
 	// Build MySQL DSN (Data Source Name) connection string
 	// Format MySQL DSN:
-	//   username:password@tcp(host:port)/dbname?charset=utf8mb4&parseTime=True&loc=Local
+	//   username:password@tcp(host:port)/dbname?charset=utf8mb4&parseTime=True&loc=UTC
+	// loc dan NowFunc memakai DB_TIMEZONE (default UTC), jadi DATETIME tersimpan
+	// dalam satu zona dan baru dikonversi ke zona bisnis saat ditampilkan
+	loc, err := time.LoadLocation(cfg.DBTimezone)
+	if err != nil {
+		return fmt.Errorf("invalid DB_TIMEZONE %q: %w", cfg.DBTimezone, err)
+	}
 	dsn := fmt.Sprintf(
-		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName,
+		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=%s",
+		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName, url.QueryEscape(cfg.DBTimezone),
 	)
 
-	var err error
 	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
 		NowFunc: func() time.Time {
-			return time.Now().UTC() // Gunakan UTC agar konsisten dengan server
+			return time.Now().In(loc) // Zona yang sama dengan loc di DSN
 		},
 	})
 
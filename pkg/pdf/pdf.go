@@ -0,0 +1,182 @@
+// Package pdf adalah PDF writer minimalis untuk dokumen teks sederhana
+// seperti invoice. Hanya mendukung font standar Helvetica, teks, garis dan
+// gambar raster, cukup untuk dokumen A4 tanpa dependency luar.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"strings"
+)
+
+// Ukuran A4 dalam point (1/72 inch)
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Document struct {
+	pages  []*bytes.Buffer
+	images []*imageObject
+}
+
+// imageObject menyimpan piksel RGB 8 bit yang sudah dikompres zlib (FlateDecode)
+type imageObject struct {
+	width  int
+	height int
+	data   []byte
+}
+
+func New() *Document {
+	d := &Document{}
+	d.AddPage()
+	return d
+}
+
+// AddPage menambah halaman baru, operasi berikutnya ditulis ke halaman ini
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+func (d *Document) current() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// Text menulis teks pada posisi (x, y) dari pojok kiri atas halaman
+func (d *Document) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.current(), "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, PageHeight-y, escape(text))
+}
+
+// Line menggambar garis dari (x1, y1) ke (x2, y2)
+func (d *Document) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(d.current(), "%.2f %.2f m %.2f %.2f l S\n", x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+// Image menggambar img di kotak (x, y, w, h) dari pojok kiri atas halaman.
+// Piksel transparan (mis. tanda tangan PNG) digabung ke latar putih.
+func (d *Document) Image(x, y, w, h float64, img image.Image) {
+	bounds := img.Bounds()
+	var data bytes.Buffer
+	zw := zlib.NewWriter(&data)
+	row := make([]byte, 0, bounds.Dx()*3)
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		row = row[:0]
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			// RGBA() sudah premultiplied, jadi cukup tambah sisa alpha sebagai putih
+			r, g, b, a := img.At(px, py).RGBA()
+			white := 0xffff - a
+			row = append(row, byte((r+white)>>8), byte((g+white)>>8), byte((b+white)>>8))
+		}
+		zw.Write(row)
+	}
+	zw.Close()
+
+	d.images = append(d.images, &imageObject{width: bounds.Dx(), height: bounds.Dy(), data: data.Bytes()})
+	fmt.Fprintf(d.current(), "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n", w, h, x, PageHeight-y-h, len(d.images))
+}
+
+// Bytes menghasilkan file PDF lengkap
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	// Object 1 = catalog, 2 = pages, 3 & 4 = font, lalu gambar,
+	// lalu pasangan page + content
+	imageStart := 5
+	pageStart := imageStart + len(d.images)
+	total := pageStart + 2*len(d.pages) - 1
+
+	write := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+	object := func(body func()) {
+		offsets = append(offsets, buf.Len())
+		write("%d 0 obj\n", len(offsets))
+		body()
+		write("\nendobj\n")
+	}
+
+	write("%%PDF-1.4\n")
+
+	object(func() { write("<< /Type /Catalog /Pages 2 0 R >>") })
+	object(func() {
+		kids := make([]string, 0, len(d.pages))
+		for i := range d.pages {
+			kids = append(kids, fmt.Sprintf("%d 0 R", pageStart+2*i))
+		}
+		write("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+	})
+	object(func() {
+		write("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	})
+	object(func() {
+		write("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	})
+
+	for _, img := range d.images {
+		img := img
+		object(func() {
+			write("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n",
+				img.width, img.height, len(img.data))
+			buf.Write(img.data)
+			write("\nendstream")
+		})
+	}
+
+	// Semua gambar didaftarkan di resource setiap halaman
+	xobjects := ""
+	if len(d.images) > 0 {
+		refs := make([]string, 0, len(d.images))
+		for i := range d.images {
+			refs = append(refs, fmt.Sprintf("/Im%d %d 0 R", i+1, imageStart+i))
+		}
+		xobjects = " /XObject << " + strings.Join(refs, " ") + " >>"
+	}
+
+	for i, page := range d.pages {
+		page := page
+		contentRef := pageStart + 2*i + 1
+		object(func() {
+			write("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >>%s >> /Contents %d 0 R >>",
+				PageWidth, PageHeight, xobjects, contentRef)
+		})
+		object(func() {
+			write("<< /Length %d >>\nstream\n", page.Len())
+			buf.Write(page.Bytes())
+			write("endstream")
+		})
+	}
+
+	xref := buf.Len()
+	write("xref\n0 %d\n0000000000 65535 f \n", total+1)
+	for _, off := range offsets {
+		write("%010d 00000 n \n", off)
+	}
+	write("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", total+1, xref)
+
+	return buf.Bytes()
+}
+
+// escape meng-escape karakter khusus string PDF dan mengganti karakter
+// di luar ASCII karena font standar tidak menjamin glyph-nya tersedia
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
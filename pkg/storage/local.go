@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local menyimpan object sebagai file di bawah Dir. Folder vehicles/ di Dir
+// disajikan sebagai static file di PublicURL (default /uploads), lihat cmd/main.go.
+type Local struct {
+	Dir       string
+	PublicURL string
+}
+
+func NewLocal(dir, publicURL string) *Local {
+	if publicURL == "" {
+		publicURL = "/uploads"
+	}
+	return &Local{Dir: dir, PublicURL: publicURL}
+}
+
+// path mengubah key menjadi path file, key dengan ".." ditolak
+func (l *Local) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if clean == "." || filepath.IsAbs(clean) || strings.HasPrefix(clean, "..") {
+		return "", errors.New("invalid storage key")
+	}
+	return filepath.Join(l.Dir, clean), nil
+}
+
+// Put implements Storage.
+func (l *Local) Put(key string, data []byte, contentType string) error {
+	dst, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0o644)
+}
+
+// Get implements Storage.
+func (l *Local) Get(key string) (io.ReadCloser, error) {
+	src, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(src)
+}
+
+// Delete implements Storage.
+// File yang sudah tidak ada tidak dianggap error.
+func (l *Local) Delete(key string) error {
+	dst, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(dst); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL implements Storage.
+func (l *Local) URL(key string) string {
+	return joinURL(l.PublicURL, key)
+}
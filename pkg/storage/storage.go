@@ -0,0 +1,52 @@
+// Package storage menyediakan penyimpanan file (foto, dokumen) yang bisa
+// diganti backend-nya: filesystem lokal atau object storage S3-compatible
+// (AWS S3, MinIO, dll). Dipilih lewat STORAGE_DRIVER.
+package storage
+
+import (
+	"fmt"
+	"go-rental/pkg/config"
+	"io"
+	"strings"
+)
+
+// Storage menyimpan object berdasarkan key relatif, contoh "vehicles/1/a.jpg"
+type Storage interface {
+	Put(key string, data []byte, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	// URL mengembalikan alamat publik object untuk ditampilkan ke client
+	URL(key string) string
+}
+
+// New membuat Storage sesuai cfg.StorageDriver (local / s3)
+func New(cfg *config.Config) (Storage, error) {
+	switch cfg.StorageDriver {
+	case "", "local":
+		return NewLocal(cfg.UploadDir, cfg.StoragePublicURL), nil
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" {
+			return nil, fmt.Errorf("S3_ENDPOINT and S3_BUCKET are required for STORAGE_DRIVER=s3")
+		}
+		s3, err := NewS3(S3Config{
+			Endpoint:     cfg.S3Endpoint,
+			Region:       cfg.S3Region,
+			Bucket:       cfg.S3Bucket,
+			AccessKey:    cfg.S3AccessKey,
+			SecretKey:    cfg.S3SecretKey,
+			UsePathStyle: cfg.S3UsePathStyle == "true",
+			PublicURL:    cfg.S3PublicURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s3, nil
+	default:
+		return nil, fmt.Errorf("invalid STORAGE_DRIVER %q, use local or s3", cfg.StorageDriver)
+	}
+}
+
+// joinURL menggabungkan base URL dan key tanpa dobel slash
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(key, "/")
+}